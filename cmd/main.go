@@ -2,16 +2,24 @@ package main
 
 import (
 	"context"
+	"errors"
 	"google.golang.org/grpc"
 	"log/slog"
 	"mod1/config"
+	"mod1/internal/lib/jwt"
+	"mod1/internal/lib/mailer"
+	"mod1/internal/lib/oidc"
+	"mod1/internal/lib/password"
+	"mod1/internal/lib/randtoken"
 	authserver "mod1/internal/server/auth"
+	"mod1/internal/server/gateway"
 	taskserver "mod1/internal/server/task"
 	authserv "mod1/internal/services/auth"
 	taskserv "mod1/internal/services/task"
 	"mod1/internal/storage"
 	authandtaskv1 "mod1/proto/gen/go"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -24,7 +32,6 @@ const (
 )
 
 var (
-	TokenTTL        = 1 * time.Hour
 	ShutdownTimeout = 10 * time.Second // можно вынести в конфиг
 )
 
@@ -46,15 +53,65 @@ func main() {
 		os.Exit(1)
 	}
 	log.Info("Starage init")
+	tokenManager, err := jwt.NewManager(cfg.AuthConf.JWT)
+	if err != nil {
+		log.Error("failed to init token manager",
+			slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	mail, err := mailer.New(cfg.MailConf, log)
+	if err != nil {
+		log.Error("failed to init mailer",
+			slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	passwordPolicy, err := password.NewPolicy(cfg.AuthConf.Password)
+	if err != nil {
+		log.Error("failed to init password policy",
+			slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	passwordHasher, err := authserv.NewPasswordHasher(cfg.AuthConf.PasswordHash)
+	if err != nil {
+		log.Error("failed to init password hasher",
+			slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	var idp authserv.IdentityProvider
+	if cfg.AuthConf.SSO.Enabled {
+		idp = oidc.New(cfg.AuthConf.SSO)
+		log.Info("SSO enabled", slog.String("issuer", cfg.AuthConf.SSO.IssuerURL))
+	}
+
 	// Инициализация сервисов
-	authService := authserv.New(log, db, db, TokenTTL)
-	taskService := taskserv.NewTaskService(db)
+	authService := authserv.New(log, db, db, db, db, db, db, db, db, db, db, db, passwordPolicy, passwordHasher, mail, tokenManager, idp, cfg.AuthConf)
+	taskService := taskserv.NewTaskService(db, cfg.TaskConf)
+
+	// Секрет, которым gateway помечает проксируемые вызовы
+	gatewaySecret, err := randtoken.New()
+	if err != nil {
+		log.Error("failed to generate gateway secret",
+			slog.String("error", err.Error()))
+		os.Exit(1)
+	}
 
 	// Настройка gRPC сервера
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(authserver.AuthInterceptor),
+		grpc.ChainUnaryInterceptor(
+			authserver.GatewayInterceptor(gatewaySecret),
+			authserver.AuthInterceptor(tokenManager, authService, authService),
+			taskserver.IdempotencyInterceptor(db, cfg.ServConf.IdempotencyTTL, log),
+		),
 	)
-	authandtaskv1.RegisterAuthServiceServer(grpcServer, &authserver.AuthServer{AuthService: authService})
+	authandtaskv1.RegisterAuthServiceServer(grpcServer, &authserver.AuthServer{
+		AuthService: authService,
+		KeySet:      tokenManager,
+		PublicURL:   cfg.ServConf.PublicURL,
+	})
 	authandtaskv1.RegisterTaskServiceServer(grpcServer, &taskserver.TaskServer{Service: taskService})
 
 	// Graceful shutdown
@@ -79,6 +136,27 @@ func main() {
 		}
 	}()
 
+	// Запуск REST gateway
+	gwCtx, gwCancel := context.WithCancel(context.Background())
+	defer gwCancel()
+
+	httpServer, err := gateway.New(gwCtx, cfg.ServConf.HostgRPC, cfg.ServConf.HostREST, gatewaySecret)
+	if err != nil {
+		log.Error("failed to init REST gateway",
+			slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	go func() {
+		log.Info("starting REST gateway",
+			slog.String("address", cfg.ServConf.HostREST))
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("failed to serve REST gateway",
+				slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+	}()
+
 	// Ожидание сигнала завершения
 	<-done
 	log.Info("server is shutting down...")
@@ -87,6 +165,12 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), ShutdownTimeout)
 	defer cancel()
 
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Warn("failed to stop REST gateway gracefully",
+			slog.String("error", err.Error()))
+	}
+	gwCancel()
+
 	stopped := make(chan struct{})
 	go func() {
 		grpcServer.GracefulStop()
@@ -2,6 +2,7 @@ package config
 
 import (
 	"log"
+	"strings"
 	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
@@ -10,14 +11,36 @@ import (
 type Config struct {
 	ServConf ServerCfg   `yaml:"server"`
 	DBConf   DatabaseCfg `yaml:"database"`
+	AuthConf AuthCfg     `yaml:"auth"`
+	MailConf MailerCfg   `yaml:"mailer"`
+	TaskConf TaskCfg     `yaml:"tasks"`
 }
 
 type ServerCfg struct {
-	HostgRPC string        `yaml:"hostgRPC" env:"HOSTgPRC" env-default:":8080"`
-	HostREST string        `yaml:"hostREST" env:"HOSTREST" env-default:":50051"`
-	Timeout  time.Duration `yaml:"timeout" env:"TIMEOUT" env-default:"10s"`
+	HostgRPC  string        `yaml:"hostgRPC" env:"HOSTgPRC" env-default:":8080"`
+	HostREST  string        `yaml:"hostREST" env:"HOSTREST" env-default:":50051"`
+	Timeout   time.Duration `yaml:"timeout" env:"TIMEOUT" env-default:"10s"`
+	PublicURL string        `yaml:"publicURL" env:"PUBLIC_URL" env-default:"http://localhost:50051"`
+	// IdempotencyTTL is how long responses of calls with an Idempotency-Key
+	// are kept for replay.
+	IdempotencyTTL time.Duration `yaml:"idempotencyTTL" env:"IDEMPOTENCY_TTL" env-default:"24h"`
 }
 
+// TaskCfg configures task hierarchy and dependencies. MaxDepth counts
+// levels, a top-level task is level 1. With EnforceDependencies a task
+// cannot be started or completed while its blockers are open.
+type TaskCfg struct {
+	MaxDepth            int    `yaml:"maxDepth" env:"TASK_MAX_DEPTH" env-default:"5"`
+	DeleteSubtasks      string `yaml:"deleteSubtasks" env:"TASK_DELETE_SUBTASKS" env-default:"cascade"`
+	EnforceDependencies bool   `yaml:"enforceDependencies" env:"TASK_ENFORCE_DEPENDENCIES" env-default:"false"`
+}
+
+// Values of TaskCfg.DeleteSubtasks.
+const (
+	DeleteSubtasksCascade  = "cascade"  // subtasks are deleted with the parent
+	DeleteSubtasksReparent = "reparent" // subtasks move to the parent of the deleted task
+)
+
 type DatabaseCfg struct {
 	Port     string `yaml:"port" env:"DB_PORT" env-default:"5432"`
 	User     string `yaml:"user" env:"DB_USER" env-default:"postgres"`
@@ -26,11 +49,135 @@ type DatabaseCfg struct {
 	Host     string `yaml:"host" env:"DB_HOST" env-default:"localhost"`
 }
 
+type AuthCfg struct {
+	TokenTTL         time.Duration     `yaml:"tokenTTL" env:"TOKEN_TTL" env-default:"1h"`
+	RefreshTokenTTL  time.Duration     `yaml:"refreshTokenTTL" env:"REFRESH_TOKEN_TTL" env-default:"720h"`
+	PasswordResetURL string            `yaml:"passwordResetURL" env:"PASSWORD_RESET_URL" env-default:"http://localhost:3000/reset-password"`
+	PasswordResetTTL time.Duration     `yaml:"passwordResetTTL" env:"PASSWORD_RESET_TTL" env-default:"1h"`
+	VerifyEmailURL   string            `yaml:"verifyEmailURL" env:"VERIFY_EMAIL_URL" env-default:"http://localhost:3000/verify-email"`
+	VerifyEmailTTL   time.Duration     `yaml:"verifyEmailTTL" env:"VERIFY_EMAIL_TTL" env-default:"24h"`
+	UnverifiedAccess string            `yaml:"unverifiedAccess" env:"UNVERIFIED_ACCESS" env-default:"read"`
+	TotpIssuer       string            `yaml:"totpIssuer" env:"TOTP_ISSUER" env-default:"TaskManagementSystem"`
+	MfaChallengeTTL  time.Duration     `yaml:"mfaChallengeTTL" env:"MFA_CHALLENGE_TTL" env-default:"5m"`
+	DeleteAccount    string            `yaml:"deleteAccount" env:"DELETE_ACCOUNT_POLICY" env-default:"cascade"`
+	ReauthMaxAge     time.Duration     `yaml:"reauthMaxAge" env:"REAUTH_MAX_AGE" env-default:"10m"`
+	JWT              JWTCfg            `yaml:"jwt"`
+	Lockout          LockoutCfg        `yaml:"lockout"`
+	SSO              SSOCfg            `yaml:"sso"`
+	Password         PasswordPolicyCfg `yaml:"passwordPolicy"`
+	PasswordHash     PasswordHashCfg   `yaml:"passwordHash"`
+}
+
+// Values of AuthCfg.UnverifiedAccess.
+const (
+	UnverifiedAccessFull = "full" // no restrictions
+	UnverifiedAccessRead = "read" // may log in, but only read tasks
+	UnverifiedAccessNone = "none" // may not log in until email is verified
+)
+
+// Values of AuthCfg.DeleteAccount.
+const (
+	DeleteAccountCascade   = "cascade"   // user and their tasks are deleted
+	DeleteAccountAnonymize = "anonymize" // personal data is removed, tasks are kept
+)
+
+// LockoutCfg controls brute-force protection of Login. Failures are counted
+// per account and per client IP. Once a counter reaches its limit within
+// Window, the key is locked for BaseLockout, and every further failure
+// doubles the lock up to MaxLockout.
+type LockoutCfg struct {
+	MaxAccountFailures int           `yaml:"maxAccountFailures" env:"LOCKOUT_MAX_ACCOUNT_FAILURES" env-default:"5"`
+	MaxIPFailures      int           `yaml:"maxIPFailures" env:"LOCKOUT_MAX_IP_FAILURES" env-default:"20"`
+	Window             time.Duration `yaml:"window" env:"LOCKOUT_WINDOW" env-default:"15m"`
+	BaseLockout        time.Duration `yaml:"baseLockout" env:"LOCKOUT_BASE" env-default:"30s"`
+	MaxLockout         time.Duration `yaml:"maxLockout" env:"LOCKOUT_MAX" env-default:"1h"`
+}
+
+// PasswordPolicyCfg describes requirements for new passwords. Character
+// classes are lowercase letters, uppercase letters, digits and symbols.
+type PasswordPolicyCfg struct {
+	MinLength          int    `yaml:"minLength" env:"PASSWORD_MIN_LENGTH" env-default:"8"`
+	MaxLength          int    `yaml:"maxLength" env:"PASSWORD_MAX_LENGTH" env-default:"72"` // bytes; bcrypt ignores anything longer
+	MinClasses         int    `yaml:"minClasses" env:"PASSWORD_MIN_CLASSES" env-default:"3"`
+	ForbidPersonalInfo bool   `yaml:"forbidPersonalInfo" env:"PASSWORD_FORBID_PERSONAL_INFO" env-default:"true"`
+	CheckBreached      bool   `yaml:"checkBreached" env:"PASSWORD_CHECK_BREACHED" env-default:"true"`
+	BreachedListPath   string `yaml:"breachedListPath" env:"PASSWORD_BREACHED_LIST_PATH"` // SHA-1 hashes; bundled list if empty
+}
+
+// PasswordHashCfg selects how new password hashes are made. Stored hashes
+// with another algorithm or weaker parameters are re-hashed on the next
+// successful login.
+type PasswordHashCfg struct {
+	Algorithm     string `yaml:"algorithm" env:"PASSWORD_HASH_ALGORITHM" env-default:"bcrypt"`
+	BcryptCost    int    `yaml:"bcryptCost" env:"PASSWORD_BCRYPT_COST" env-default:"12"`
+	Argon2Time    int    `yaml:"argon2Time" env:"PASSWORD_ARGON2_TIME" env-default:"3"`
+	Argon2Memory  int    `yaml:"argon2Memory" env:"PASSWORD_ARGON2_MEMORY" env-default:"65536"` // KiB
+	Argon2Threads int    `yaml:"argon2Threads" env:"PASSWORD_ARGON2_THREADS" env-default:"2"`
+}
+
+// Values of PasswordHashCfg.Algorithm.
+const (
+	PasswordHashBcrypt   = "bcrypt"
+	PasswordHashArgon2id = "argon2id"
+)
+
+// SSOCfg configures login through an external OpenID Connect provider
+// (authorization code flow with PKCE). RedirectURL must point to the
+// SSO callback of the REST gateway, i.e. <publicURL>/v1/auth/sso/callback.
+type SSOCfg struct {
+	Enabled       bool          `yaml:"enabled" env:"SSO_ENABLED" env-default:"false"`
+	Name          string        `yaml:"name" env:"SSO_NAME" env-default:"oidc"` // stored with linked identities
+	IssuerURL     string        `yaml:"issuerURL" env:"SSO_ISSUER_URL"`
+	ClientID      string        `yaml:"clientID" env:"SSO_CLIENT_ID"`
+	ClientSecret  string        `yaml:"clientSecret" env:"SSO_CLIENT_SECRET"`
+	RedirectURL   string        `yaml:"redirectURL" env:"SSO_REDIRECT_URL" env-default:"http://localhost:50051/v1/auth/sso/callback"`
+	Scopes        []string      `yaml:"scopes" env:"SSO_SCOPES" env-default:"openid,email,profile"`
+	AutoProvision bool          `yaml:"autoProvision" env:"SSO_AUTO_PROVISION" env-default:"true"` // create users on first login
+	StateTTL      time.Duration `yaml:"stateTTL" env:"SSO_STATE_TTL" env-default:"10m"`
+}
+
+// JWTCfg describes keys used to sign and verify access tokens.
+// Tokens are signed with ActiveKey; all other listed keys are only used
+// for verification, so a key can be rotated out by first making another
+// key active and removing the old one after TokenTTL has passed.
+// Issuer defaults to server.publicURL: OIDC discovery requires the iss
+// claim to be the URL the discovery document is served from.
+type JWTCfg struct {
+	Issuer    string          `yaml:"issuer" env:"JWT_ISSUER"`
+	ActiveKey string          `yaml:"activeKey" env:"JWT_ACTIVE_KEY"`
+	Keys      []SigningKeyCfg `yaml:"keys"`
+}
+
+type SigningKeyCfg struct {
+	ID             string `yaml:"id"`
+	Algorithm      string `yaml:"algorithm"`      // HS256, RS256 or ES256
+	Secret         string `yaml:"secret"`         // HS256 only
+	PrivateKeyPath string `yaml:"privateKeyPath"` // PEM, RS256/ES256
+	PublicKeyPath  string `yaml:"publicKeyPath"`  // PEM, used when private key is not available
+}
+
+type MailerCfg struct {
+	Driver   string  `yaml:"driver" env:"MAILER_DRIVER" env-default:"log"` // smtp, file or log
+	From     string  `yaml:"from" env:"MAILER_FROM" env-default:"no-reply@localhost"`
+	FilePath string  `yaml:"filePath" env:"MAILER_FILE_PATH" env-default:"mail.log"`
+	SMTP     SMTPCfg `yaml:"smtp"`
+}
+
+type SMTPCfg struct {
+	Host     string `yaml:"host" env:"SMTP_HOST" env-default:"localhost"`
+	Port     string `yaml:"port" env:"SMTP_PORT" env-default:"587"`
+	Username string `yaml:"username" env:"SMTP_USERNAME"`
+	Password string `yaml:"password" env:"SMTP_PASSWORD"`
+}
+
 func MustLoad() *Config {
 	cfg := Config{}
 	err := cleanenv.ReadConfig("config.yaml", &cfg)
 	if err != nil {
 		log.Fatalf("cannot read config: %s", err)
 	}
+	if cfg.AuthConf.JWT.Issuer == "" {
+		cfg.AuthConf.JWT.Issuer = strings.TrimSuffix(cfg.ServConf.PublicURL, "/")
+	}
 	return &cfg
 }
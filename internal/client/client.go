@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"google.golang.org/grpc/metadata"
 	"log"
@@ -9,15 +10,23 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	taskv1 "mod1/proto/gen/go"
 )
 
+// ErrMfaRequired is returned by Login when the account has TOTP enabled.
+// Call VerifyMfa with a code to finish logging in.
+var ErrMfaRequired = errors.New("second factor is required")
+
 type TaskClient struct {
-	authClient taskv1.AuthServiceClient // новый клиент
-	taskClient taskv1.TaskServiceClient
-	conn       *grpc.ClientConn
-	token      string
+	authClient   taskv1.AuthServiceClient // новый клиент
+	taskClient   taskv1.TaskServiceClient
+	conn         *grpc.ClientConn
+	token        string
+	refreshToken string
+	mfaToken     string
+	apiKey       string
 }
 
 func NewTaskClient(addr string) (*TaskClient, error) {
@@ -37,17 +46,32 @@ func (c *TaskClient) SetToken(token string) {
 	c.token = token
 }
 
+// SetApiKey makes the client authenticate with a personal API key instead of
+// a token obtained by Login. Useful for CI jobs and scripts.
+func (c *TaskClient) SetApiKey(key string) {
+	c.apiKey = key
+}
+
 func (c *TaskClient) Close() error {
 	return c.conn.Close()
 }
 
+// WithIdempotencyKey marks calls made with ctx (CreateTask, UpdateTask,
+// DeleteTask) with key, so that retrying them with the same key does not
+// apply the change twice.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "idempotency-key", key)
+}
+
 func (c *TaskClient) withAuth(ctx context.Context) context.Context {
+	if c.apiKey != "" {
+		return metadata.AppendToOutgoingContext(ctx, "authorization", "ApiKey "+c.apiKey)
+	}
 	if c.token == "" {
 		return ctx
 	}
 
-	md := metadata.Pairs("authorization", "Bearer "+c.token)
-	return metadata.NewOutgoingContext(ctx, md)
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+c.token)
 }
 
 func (c *TaskClient) Register(ctx context.Context, username, email, password string) (int64, error) {
@@ -73,10 +97,60 @@ func (c *TaskClient) Login(ctx context.Context, email, password string) (string,
 		log.Printf("Login failed: %v", err)
 		return "", err
 	}
+	if resp.MfaRequired {
+		c.mfaToken = resp.MfaToken
+		return "", ErrMfaRequired
+	}
 	c.token = resp.Token
+	c.refreshToken = resp.RefreshToken
 	return resp.Token, nil
 }
 
+// VerifyMfa completes Login that returned ErrMfaRequired.
+// Code is a TOTP code or one of the recovery codes.
+func (c *TaskClient) VerifyMfa(ctx context.Context, code string) (string, error) {
+	resp, err := c.authClient.VerifyMfa(ctx, &taskv1.VerifyMfaRequest{
+		MfaToken: c.mfaToken,
+		Code:     code,
+	})
+	if err != nil {
+		log.Printf("VerifyMfa failed: %v", err)
+		return "", err
+	}
+	c.mfaToken = ""
+	c.token = resp.Token
+	c.refreshToken = resp.RefreshToken
+	return resp.Token, nil
+}
+
+// RefreshToken exchanges the refresh token received on Login for a new access token.
+func (c *TaskClient) RefreshToken(ctx context.Context) (string, error) {
+	resp, err := c.authClient.RefreshToken(ctx, &taskv1.RefreshTokenRequest{
+		RefreshToken: c.refreshToken,
+	})
+	if err != nil {
+		log.Printf("RefreshToken failed: %v", err)
+		return "", err
+	}
+	c.token = resp.Token
+	c.refreshToken = resp.RefreshToken
+	return resp.Token, nil
+}
+
+// Logout revokes the current access and refresh tokens.
+func (c *TaskClient) Logout(ctx context.Context) error {
+	_, err := c.authClient.Logout(c.withAuth(ctx), &taskv1.LogoutRequest{
+		RefreshToken: c.refreshToken,
+	})
+	if err != nil {
+		log.Printf("Logout failed: %v", err)
+		return err
+	}
+	c.token = ""
+	c.refreshToken = ""
+	return nil
+}
+
 func (c *TaskClient) CreateTask(ctx context.Context, title, description string, dueDate time.Time) (*taskv1.Task, error) {
 	resp, err := c.taskClient.CreateTask(c.withAuth(ctx), &taskv1.CreateTaskRequest{
 		Title:       title,
@@ -114,6 +188,27 @@ func (c *TaskClient) UpdateTask(ctx context.Context, id int64, title, descriptio
 	return resp.Task, nil
 }
 
+// UpdateTaskFields changes only the listed fields (title, description,
+// due_date, status), taking new values from task. Listing due_date with
+// task.DueDate unset clears the due date. If task.Version is set, the update
+// fails with codes.Aborted when the task has been changed since.
+func (c *TaskClient) UpdateTaskFields(ctx context.Context, id int64, task *taskv1.Task, paths ...string) (*taskv1.Task, error) {
+	resp, err := c.taskClient.UpdateTask(c.withAuth(ctx), &taskv1.UpdateTaskRequest{
+		Id:              id,
+		Title:           task.Title,
+		Description:     task.Description,
+		DueDate:         task.DueDate,
+		Status:          task.Status,
+		UpdateMask:      &fieldmaskpb.FieldMask{Paths: paths},
+		ExpectedVersion: task.Version,
+	})
+	if err != nil {
+		log.Printf("UpdateTask failed: %v", err)
+		return nil, err
+	}
+	return resp.Task, nil
+}
+
 func (c *TaskClient) DeleteTask(ctx context.Context, id int64) (bool, error) {
 	resp, err := c.taskClient.DeleteTask(c.withAuth(ctx), &taskv1.DeleteTaskRequest{Id: id})
 	if err != nil {
@@ -123,14 +218,37 @@ func (c *TaskClient) DeleteTask(ctx context.Context, id int64) (bool, error) {
 	return resp.Success, nil
 }
 
+// ListTasks returns a page of own tasks. Zero dueDateFrom and dueDateTo
+// do not filter.
 func (c *TaskClient) ListTasks(ctx context.Context, status taskv1.TaskStatus, dueDateFrom, dueDateTo time.Time, pageSize, pageToken int32) ([]*taskv1.Task, int32, error) {
-	resp, err := c.taskClient.ListTasks(c.withAuth(ctx), &taskv1.ListTasksRequest{
-		Status:      status,
-		DueDateFrom: timestamppb.New(dueDateFrom),
-		DueDateTo:   timestamppb.New(dueDateTo),
-		PageSize:    pageSize,
-		PageToken:   pageToken,
+	req := &taskv1.ListTasksRequest{
+		Status:    status,
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	}
+	if !dueDateFrom.IsZero() {
+		req.DueDateFrom = timestamppb.New(dueDateFrom)
+	}
+	if !dueDateTo.IsZero() {
+		req.DueDateTo = timestamppb.New(dueDateTo)
+	}
+
+	return c.listTasks(ctx, req)
+}
+
+// ListTasksByPriority returns a page of own tasks with the given priority
+// (all if unspecified), most urgent first and then by due date.
+func (c *TaskClient) ListTasksByPriority(ctx context.Context, priority taskv1.TaskPriority, pageSize, pageToken int32) ([]*taskv1.Task, int32, error) {
+	return c.listTasks(ctx, &taskv1.ListTasksRequest{
+		Priority:  priority,
+		OrderBy:   "priority desc, due_date",
+		PageSize:  pageSize,
+		PageToken: pageToken,
 	})
+}
+
+func (c *TaskClient) listTasks(ctx context.Context, req *taskv1.ListTasksRequest) ([]*taskv1.Task, int32, error) {
+	resp, err := c.taskClient.ListTasks(c.withAuth(ctx), req)
 	if err != nil {
 		log.Printf("ListTasks failed: %v", err)
 		return nil, 0, err
@@ -0,0 +1,82 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"sort"
+)
+
+// JWK is a public key in JSON Web Key format (RFC 7517).
+type JWK struct {
+	Kid string
+	Kty string
+	Alg string
+	Use string
+	N   string // RSA modulus
+	E   string // RSA exponent
+	Crv string // EC curve
+	X   string // EC point
+	Y   string
+}
+
+// JWKS returns public parts of all asymmetric keys, including keys that
+// no longer sign but may still verify tokens. Symmetric keys are never published.
+func (m *Manager) JWKS() []JWK {
+	kids := make([]string, 0, len(m.keys))
+	for kid := range m.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	keys := make([]JWK, 0, len(kids))
+	for _, kid := range kids {
+		key := m.keys[kid]
+		jwk := JWK{Kid: kid, Alg: key.method.Alg(), Use: "sig"}
+
+		switch pub := key.public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case *ecdsa.PublicKey:
+			size := (pub.Curve.Params().BitSize + 7) / 8
+			jwk.Kty = "EC"
+			jwk.Crv = pub.Curve.Params().Name
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, size)))
+			jwk.Y = base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, size)))
+		default:
+			continue
+		}
+
+		keys = append(keys, jwk)
+	}
+
+	return keys
+}
+
+// Algorithms returns signing algorithms of the keys published in JWKS.
+// Symmetric keys are left out: relying parties cannot verify with them.
+func (m *Manager) Algorithms() []string {
+	seen := make(map[string]bool)
+	var algs []string
+	for _, key := range m.keys {
+		if key.public == nil {
+			continue
+		}
+		alg := key.method.Alg()
+		if !seen[alg] {
+			seen[alg] = true
+			algs = append(algs, alg)
+		}
+	}
+	sort.Strings(algs)
+
+	return algs
+}
+
+// Issuer returns the value of the iss claim of issued tokens.
+func (m *Manager) Issuer() string {
+	return m.issuer
+}
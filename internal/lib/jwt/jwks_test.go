@@ -0,0 +1,66 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"mod1/config"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeECKey(t *testing.T) string {
+	t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	der, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "es256.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+	return path
+}
+
+func TestAlgorithmsOnlyPublishedKeys(t *testing.T) {
+	hs := config.SigningKeyCfg{ID: "hs", Algorithm: "HS256", Secret: "secret"}
+	es := config.SigningKeyCfg{ID: "es", Algorithm: "ES256", PrivateKeyPath: writeECKey(t)}
+
+	tests := []struct {
+		name    string
+		active  string
+		keys    []config.SigningKeyCfg
+		want    []string
+		wantJWK int
+	}{
+		{name: "symmetric only", active: "hs", keys: []config.SigningKeyCfg{hs}, want: nil, wantJWK: 0},
+		{name: "asymmetric only", active: "es", keys: []config.SigningKeyCfg{es}, want: []string{"ES256"}, wantJWK: 1},
+		{name: "mixed", active: "hs", keys: []config.SigningKeyCfg{hs, es}, want: []string{"ES256"}, wantJWK: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewManager(config.JWTCfg{Issuer: "http://localhost", ActiveKey: tt.active, Keys: tt.keys})
+			if err != nil {
+				t.Fatalf("NewManager: %v", err)
+			}
+
+			if got := m.Algorithms(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Algorithms() = %v, want %v", got, tt.want)
+			}
+			if got := len(m.JWKS()); got != tt.wantJWK {
+				t.Errorf("len(JWKS()) = %d, want %d", got, tt.wantJWK)
+			}
+		})
+	}
+}
@@ -1,26 +1,151 @@
 package jwt
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	"mod1/config"
+	"mod1/internal/lib/randtoken"
 	"mod1/internal/models"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
-// NewToken creates new JWT token for given user and app.
-func NewToken(user models.User, duration time.Duration) (string, error) {
-	token := jwt.New(jwt.SigningMethodHS256)
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrUnknownKey   = errors.New("unknown signing key")
+)
+
+// Claims are the claims carried by access tokens.
+type Claims struct {
+	UserID     int64  `json:"uid"`
+	Email      string `json:"email"`
+	Generation int64  `json:"gen"`
+	Role       string `json:"role"`
+	// Scope restricts the token to listed scopes (space separated).
+	// Empty scope means the token is not restricted.
+	Scope string `json:"scope,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Manager issues and verifies access tokens. It holds a set of keys
+// identified by kid: the active key signs new tokens, the rest are kept
+// to verify tokens signed before a rotation.
+type Manager struct {
+	issuer    string
+	activeKID string
+	keys      map[string]*signingKey
+}
 
-	claims := token.Claims.(jwt.MapClaims)
-	claims["uid"] = user.ID
-	claims["email"] = user.Email
-	claims["exp"] = time.Now().Add(duration).Unix()
+// NewManager loads signing keys described by cfg.
+func NewManager(cfg config.JWTCfg) (*Manager, error) {
+	const op = "jwt.NewManager"
+
+	if len(cfg.Keys) == 0 {
+		return nil, fmt.Errorf("%s: no signing keys configured", op)
+	}
+
+	m := &Manager{
+		issuer:    cfg.Issuer,
+		activeKID: cfg.ActiveKey,
+		keys:      make(map[string]*signingKey, len(cfg.Keys)),
+	}
+
+	for _, kc := range cfg.Keys {
+		if _, ok := m.keys[kc.ID]; ok {
+			return nil, fmt.Errorf("%s: duplicate key id %q", op, kc.ID)
+		}
+		key, err := loadKey(kc)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		m.keys[kc.ID] = key
+	}
+
+	if m.activeKID == "" && len(cfg.Keys) == 1 {
+		m.activeKID = cfg.Keys[0].ID
+	}
+	active, ok := m.keys[m.activeKID]
+	if !ok {
+		return nil, fmt.Errorf("%s: active key %q is not configured", op, m.activeKID)
+	}
+	if active.sign == nil {
+		return nil, fmt.Errorf("%s: active key %q has no private key", op, m.activeKID)
+	}
+
+	return m, nil
+}
 
-	tokenString, err := token.SignedString([]byte(models.Secret))
+// NewToken creates new JWT token for given user signed with the active key
+// and returns it together with its ID (jti claim).
+// If scopes are given, the token is restricted to them.
+func (m *Manager) NewToken(user models.User, duration time.Duration, scopes ...string) (string, string, error) {
+	jti, err := randtoken.New()
 	if err != nil {
-		return "", err
+		return "", "", err
+	}
+
+	now := time.Now()
+	claims := Claims{
+		UserID:     user.ID,
+		Email:      user.Email,
+		Generation: user.TokenGeneration,
+		Role:       user.Role,
+		Scope:      strings.Join(scopes, " "),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			Issuer:    m.issuer,
+			Subject:   fmt.Sprint(user.ID),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
+		},
+	}
+
+	key := m.keys[m.activeKID]
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = m.activeKID
+
+	tokenString, err := token.SignedString(key.sign)
+	if err != nil {
+		return "", "", err
+	}
+
+	return tokenString, jti, nil
+}
+
+// Verify checks token signature, issuer and expiration and returns its claims.
+func (m *Manager) Verify(tokenString string) (*Claims, error) {
+	const op = "jwt.Verify"
+
+	if tokenString == "" {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidToken)
+	}
+
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := m.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownKey, kid)
+		}
+		// The algorithm is bound to the key, never taken from the token.
+		if token.Method.Alg() != key.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return key.verify, nil
+	},
+		jwt.WithIssuer(m.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w: %w", op, ErrInvalidToken, err)
+	}
+
+	if claims.UserID == 0 || claims.ID == "" {
+		return nil, fmt.Errorf("%s: %w: required claims are missing", op, ErrInvalidToken)
 	}
 
-	return tokenString, nil
+	return claims, nil
 }
@@ -0,0 +1,106 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/elliptic"
+	"fmt"
+	"os"
+
+	"mod1/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type signingKey struct {
+	method jwt.SigningMethod
+	sign   interface{} // nil for verification-only keys
+	verify interface{}
+	public crypto.PublicKey // nil for symmetric keys
+}
+
+func loadKey(cfg config.SigningKeyCfg) (*signingKey, error) {
+	if cfg.ID == "" {
+		return nil, fmt.Errorf("key id is required")
+	}
+
+	switch cfg.Algorithm {
+	case "HS256":
+		if cfg.Secret == "" {
+			return nil, fmt.Errorf("key %q: secret is required for HS256", cfg.ID)
+		}
+		return &signingKey{
+			method: jwt.SigningMethodHS256,
+			sign:   []byte(cfg.Secret),
+			verify: []byte(cfg.Secret),
+		}, nil
+	case "RS256":
+		key := &signingKey{method: jwt.SigningMethodRS256}
+		if cfg.PrivateKeyPath != "" {
+			data, err := os.ReadFile(cfg.PrivateKeyPath)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: read private key: %w", cfg.ID, err)
+			}
+			priv, err := jwt.ParseRSAPrivateKeyFromPEM(data)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: parse private key: %w", cfg.ID, err)
+			}
+			key.sign, key.verify, key.public = priv, &priv.PublicKey, &priv.PublicKey
+			return key, nil
+		}
+		data, err := readPublicKey(cfg)
+		if err != nil {
+			return nil, err
+		}
+		pub, err := jwt.ParseRSAPublicKeyFromPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: parse public key: %w", cfg.ID, err)
+		}
+		key.verify, key.public = pub, pub
+		return key, nil
+	case "ES256":
+		key := &signingKey{method: jwt.SigningMethodES256}
+		if cfg.PrivateKeyPath != "" {
+			data, err := os.ReadFile(cfg.PrivateKeyPath)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: read private key: %w", cfg.ID, err)
+			}
+			priv, err := jwt.ParseECPrivateKeyFromPEM(data)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: parse private key: %w", cfg.ID, err)
+			}
+			if priv.Curve != elliptic.P256() {
+				return nil, fmt.Errorf("key %q: ES256 requires P-256 curve", cfg.ID)
+			}
+			key.sign, key.verify, key.public = priv, &priv.PublicKey, &priv.PublicKey
+			return key, nil
+		}
+		data, err := readPublicKey(cfg)
+		if err != nil {
+			return nil, err
+		}
+		pub, err := jwt.ParseECPublicKeyFromPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: parse public key: %w", cfg.ID, err)
+		}
+		if pub.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("key %q: ES256 requires P-256 curve", cfg.ID)
+		}
+		key.verify, key.public = pub, pub
+		return key, nil
+	default:
+		return nil, fmt.Errorf("key %q: unsupported algorithm %q", cfg.ID, cfg.Algorithm)
+	}
+}
+
+func readPublicKey(cfg config.SigningKeyCfg) ([]byte, error) {
+	if cfg.PublicKeyPath == "" {
+		return nil, fmt.Errorf("key %q: private or public key path is required for %s", cfg.ID, cfg.Algorithm)
+	}
+
+	data, err := os.ReadFile(cfg.PublicKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("key %q: read public key: %w", cfg.ID, err)
+	}
+
+	return data, nil
+}
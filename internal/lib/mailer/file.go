@@ -0,0 +1,61 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// File appends emails to a file instead of sending them.
+// Intended for local development and tests.
+type File struct {
+	mu   sync.Mutex
+	path string
+	from string
+}
+
+func NewFile(path, from string) *File {
+	return &File{path: path, from: from}
+}
+
+func (m *File) Send(ctx context.Context, to, subject, body string) error {
+	const op = "mailer.File.Send"
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "Date: %s\nFrom: %s\nTo: %s\nSubject: %s\n\n%s\n\n---\n",
+		time.Now().Format(time.RFC1123Z), m.from, to, subject, body)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Log writes emails to the application log instead of sending them.
+type Log struct {
+	log *slog.Logger
+}
+
+func NewLog(log *slog.Logger) *Log {
+	return &Log{log: log}
+}
+
+func (m *Log) Send(ctx context.Context, to, subject, body string) error {
+	m.log.Info("email",
+		slog.String("to", to),
+		slog.String("subject", subject),
+		slog.String("body", body),
+	)
+	return nil
+}
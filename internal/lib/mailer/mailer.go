@@ -0,0 +1,27 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"mod1/config"
+)
+
+// Mailer sends plain text emails.
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// New creates mailer selected by cfg.Driver: "smtp", "file" or "log".
+func New(cfg config.MailerCfg, log *slog.Logger) (Mailer, error) {
+	switch cfg.Driver {
+	case "smtp":
+		return NewSMTP(cfg.SMTP, cfg.From), nil
+	case "file":
+		return NewFile(cfg.FilePath, cfg.From), nil
+	case "log", "":
+		return NewLog(log), nil
+	default:
+		return nil, fmt.Errorf("mailer.New: unknown driver %q", cfg.Driver)
+	}
+}
@@ -0,0 +1,198 @@
+// Package password checks passwords against the configured policy.
+package password
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"mod1/config"
+)
+
+// minPersonalInfoLen is the shortest username or email part that is looked
+// for in passwords; shorter ones would reject too many passwords by chance.
+const minPersonalInfoLen = 3
+
+// Reasons reported in Violation.Reason.
+const (
+	ReasonTooShort      = "PASSWORD_TOO_SHORT"
+	ReasonTooLong       = "PASSWORD_TOO_LONG"
+	ReasonTooFewClasses = "PASSWORD_TOO_FEW_CHARACTER_CLASSES"
+	ReasonPersonalInfo  = "PASSWORD_CONTAINS_PERSONAL_INFO"
+	ReasonBreached      = "PASSWORD_BREACHED"
+)
+
+//go:embed common_passwords.txt
+var bundledList []byte
+
+// Violation is a policy rule the password does not satisfy.
+type Violation struct {
+	Reason      string
+	Description string
+}
+
+type Policy struct {
+	cfg      config.PasswordPolicyCfg
+	breached map[string]struct{}
+}
+
+// NewPolicy loads the breached password list: the file at cfg.BreachedListPath
+// or, if it is empty, the bundled list of common passwords.
+func NewPolicy(cfg config.PasswordPolicyCfg) (*Policy, error) {
+	p := &Policy{cfg: cfg}
+
+	if !cfg.CheckBreached {
+		return p, nil
+	}
+
+	var r io.Reader = bytes.NewReader(bundledList)
+	if cfg.BreachedListPath != "" {
+		f, err := os.Open(cfg.BreachedListPath)
+		if err != nil {
+			return nil, fmt.Errorf("open breached password list: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	breached, err := loadHashes(r)
+	if err != nil {
+		return nil, fmt.Errorf("load breached password list: %w", err)
+	}
+	p.breached = breached
+
+	return p, nil
+}
+
+// Check returns all rules password violates, or nil if it is acceptable.
+// username and email are used to reject passwords built from them.
+func (p *Policy) Check(password, username, email string) []Violation {
+	var violations []Violation
+
+	length := utf8.RuneCountInString(password)
+	if length < p.cfg.MinLength {
+		violations = append(violations, Violation{
+			Reason:      ReasonTooShort,
+			Description: fmt.Sprintf("must be at least %d characters long", p.cfg.MinLength),
+		})
+	}
+	// MaxLength is in bytes, as hash functions see it.
+	if p.cfg.MaxLength > 0 && len(password) > p.cfg.MaxLength {
+		violations = append(violations, Violation{
+			Reason:      ReasonTooLong,
+			Description: fmt.Sprintf("must be at most %d bytes long", p.cfg.MaxLength),
+		})
+	}
+
+	if classes := characterClasses(password); classes < p.cfg.MinClasses {
+		violations = append(violations, Violation{
+			Reason: ReasonTooFewClasses,
+			Description: fmt.Sprintf("must contain at least %d of: lowercase letters, uppercase letters, digits, symbols",
+				p.cfg.MinClasses),
+		})
+	}
+
+	if p.cfg.ForbidPersonalInfo && containsPersonalInfo(password, username, email) {
+		violations = append(violations, Violation{
+			Reason:      ReasonPersonalInfo,
+			Description: "must not contain the username or email",
+		})
+	}
+
+	if p.isBreached(password) {
+		violations = append(violations, Violation{
+			Reason:      ReasonBreached,
+			Description: "is too common or appeared in a data breach",
+		})
+	}
+
+	return violations
+}
+
+func (p *Policy) isBreached(password string) bool {
+	if p.breached == nil {
+		return false
+	}
+
+	for _, candidate := range []string{password, strings.ToLower(password)} {
+		sum := sha1.Sum([]byte(candidate))
+		if _, ok := p.breached[strings.ToUpper(hex.EncodeToString(sum[:]))]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func characterClasses(password string) int {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	classes := 0
+	for _, present := range []bool{lower, upper, digit, symbol} {
+		if present {
+			classes++
+		}
+	}
+
+	return classes
+}
+
+func containsPersonalInfo(password, username, email string) bool {
+	password = strings.ToLower(password)
+
+	local, _, _ := strings.Cut(email, "@")
+	for _, part := range []string{username, email, local} {
+		part = strings.ToLower(part)
+		if len(part) >= minPersonalInfoLen && strings.Contains(password, part) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadHashes reads upper-case hex SHA-1 hashes, one per line. Empty lines,
+// comments (#) and ":count" suffixes are ignored.
+func loadHashes(r io.Reader) (map[string]struct{}, error) {
+	hashes := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		hash, _, _ := strings.Cut(line, ":")
+		if len(hash) != sha1.Size*2 {
+			return nil, fmt.Errorf("invalid hash %q", hash)
+		}
+		hashes[strings.ToUpper(hash)] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return hashes, nil
+}
@@ -0,0 +1,91 @@
+package totp
+
+import (
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 seed of RFC 6238 appendix B ("12345678901234567890").
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	// RFC 6238 appendix B lists 8-digit codes; with 6 digits the last six remain.
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatalf("Code(%d): %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Errorf("Code(%d) = %q, want %q", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestCodeLowercaseSecret(t *testing.T) {
+	got, err := Code("gezdgnbvgy3tqojqgezdgnbvgy3tqojq", Step(time.Unix(59, 0)))
+	if err != nil {
+		t.Fatalf("Code: %v", err)
+	}
+	if got != "287082" {
+		t.Errorf("Code = %q, want %q", got, "287082")
+	}
+}
+
+func TestCodeInvalidSecret(t *testing.T) {
+	if _, err := Code("not base32!", 1); err == nil {
+		t.Error("Code accepted an invalid secret")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	step := Step(now)
+
+	codeAt := func(s int64) string {
+		code, err := Code(rfcSecret, s)
+		if err != nil {
+			t.Fatalf("Code(%d): %v", s, err)
+		}
+		return code
+	}
+
+	tests := []struct {
+		name     string
+		code     string
+		wantOK   bool
+		wantStep int64
+	}{
+		{name: "current step", code: codeAt(step), wantOK: true, wantStep: step},
+		{name: "previous step", code: codeAt(step - 1), wantOK: true, wantStep: step - 1},
+		{name: "next step", code: codeAt(step + 1), wantOK: true, wantStep: step + 1},
+		{name: "two steps behind", code: codeAt(step - 2)},
+		{name: "two steps ahead", code: codeAt(step + 2)},
+		{name: "too short", code: "50471"},
+		{name: "too long", code: "0050471"},
+		{name: "empty", code: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStep, ok := Validate(tt.code, rfcSecret, now)
+			if ok != tt.wantOK {
+				t.Fatalf("Validate(%q) ok = %v, want %v", tt.code, ok, tt.wantOK)
+			}
+			if ok && gotStep != tt.wantStep {
+				t.Errorf("Validate(%q) step = %d, want %d", tt.code, gotStep, tt.wantStep)
+			}
+		})
+	}
+}
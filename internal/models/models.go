@@ -6,12 +6,75 @@ import (
 )
 
 type User struct {
-	ID       int64
-	Username string
-	Email    string
-	PassHash []byte
+	ID              int64
+	Username        string
+	Email           string
+	PassHash        []byte
+	TokenGeneration int64 // incremented on "logout everywhere", older tokens are rejected
+	Role            string
+	Disabled        bool
+	EmailVerified   bool
+	TotpEnabled     bool // second factor is required on login
+	CreatedAt       time.Time
 }
 
+const (
+	RoleMember = "member"
+	RoleAdmin  = "admin"
+)
+
+type RefreshToken struct {
+	ID        int64
+	UserID    int64
+	SessionID int64 // 0 for tokens issued before sessions were tracked
+	TokenHash string
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+}
+
+// Session is a login on one device. It survives token refreshes and ends
+// on logout or revocation.
+type Session struct {
+	ID         int64
+	UserID     int64
+	UserAgent  string
+	IP         string
+	AccessJTI  string // jti of the latest access token issued for the session
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	RevokedAt  *time.Time
+}
+
+// IdempotencyKey is a mutating call remembered by its Idempotency-Key.
+// Response is nil while the first call with the key is still running.
+type IdempotencyKey struct {
+	Method      string
+	RequestHash string
+	Response    []byte
+}
+
+// ApiKey is a long-lived personal key for automation.
+// Only a hash of the key is stored, Prefix is kept to let users tell keys apart.
+type ApiKey struct {
+	ID         int64
+	UserID     int64
+	Name       string
+	Prefix     string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+const (
+	ScopeTasksRead  = "tasks:read"
+	ScopeTasksWrite = "tasks:write"
+	// ScopeAccount allows managing own sessions and profile. It is never
+	// granted to API keys, only to restricted access tokens.
+	ScopeAccount = "account"
+)
+
 type Task struct {
 	ID          int64
 	UserID      int64
@@ -23,6 +86,112 @@ type Task struct {
 	UpdatedAt   time.Time
 }
 
+// TaskUpdate is a partial update of a task: nil fields are left unchanged.
+// Due date is changed only if UpdateDueDate is set, then nil DueDate clears it.
+type TaskUpdate struct {
+	Title         *string
+	Description   *string
+	Status        *TaskStatus
+	Priority      *TaskPriority
+	UpdateDueDate bool
+	DueDate       *time.Time
+	UpdateTags    bool
+	Tags          []string // replaces all tags of the task
+	UpdateProject bool
+	ProjectID     int64 // 0 takes the task out of its project
+	UpdateParent  bool
+	ParentID      int64 // 0 makes the task top-level
+	// RequireUnblocked fails the update if it changes Status while the task
+	// has open blockers.
+	RequireUnblocked bool
+}
+
+// NewTask holds fields of a task being created. ProjectID and ParentID
+// are optional (0).
+type NewTask struct {
+	Title       string
+	Description string
+	DueDate     *time.Time
+	Status      TaskStatus
+	Priority    TaskPriority
+	Tags        []string
+	ProjectID   int64
+	ParentID    int64
+}
+
+// TaskFilter selects and orders tasks in ListTasks; nil fields do not filter.
+type TaskFilter struct {
+	Status      *TaskStatus
+	Priority    *TaskPriority
+	DueDateFrom *time.Time
+	DueDateTo   *time.Time
+	ProjectID   *int64
+	ParentID    *int64 // subtasks of the task
+	TopLevel    bool   // only tasks without a parent
+	OwnedOnly   bool   // skip tasks shared with the user
+	Tags        TagFilter
+	OrderBy     []TaskOrder
+}
+
+// TagFilter selects tasks by tag names, compared case-insensitively.
+// Empty lists do not filter.
+type TagFilter struct {
+	AnyOf  []string
+	AllOf  []string
+	NoneOf []string
+}
+
+// Project groups tasks of a user. Archived projects accept no new tasks.
+type Project struct {
+	ID          int64
+	UserID      int64
+	Name        string
+	Description string
+	Color       string // #rrggbb or empty
+	Archived    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Member is a user a task or a project is shared with.
+type Member struct {
+	UserID    int64
+	Username  string
+	Email     string
+	Role      MemberRole
+	CreatedAt time.Time
+}
+
+// ProjectUpdate is a partial update of a project: nil fields are left unchanged.
+type ProjectUpdate struct {
+	Name        *string
+	Description *string
+	Color       *string
+	Archived    *bool
+}
+
+// Tag is a label a user puts on their tasks.
+type Tag struct {
+	ID        int64
+	UserID    int64
+	Name      string
+	CreatedAt time.Time
+}
+
+// TaskOrder is a sort key of ListTasks, one of the TaskOrder* fields.
+type TaskOrder struct {
+	Field string
+	Desc  bool
+}
+
+// Fields tasks can be sorted by.
+const (
+	TaskOrderPriority  = "priority"
+	TaskOrderDueDate   = "due_date"
+	TaskOrderCreatedAt = "created_at"
+	TaskOrderUpdatedAt = "updated_at"
+)
+
 type TaskStatus int32
 
 const (
@@ -54,6 +223,57 @@ func (ts TaskStatus) String() string {
 	}
 }
 
+type TaskPriority int32
+
+const (
+	TASK_PRIORITY_UNSPECIFIED TaskPriority = 0
+	TASK_PRIORITY_LOW         TaskPriority = 1
+	TASK_PRIORITY_MEDIUM      TaskPriority = 2
+	TASK_PRIORITY_HIGH        TaskPriority = 3
+	TASK_PRIORITY_URGENT      TaskPriority = 4
+)
+
+// String returns the string representation of the TaskPriority.
+func (tp TaskPriority) String() string {
+	switch tp {
+	case TASK_PRIORITY_UNSPECIFIED:
+		return "UNSPECIFIED"
+	case TASK_PRIORITY_LOW:
+		return "LOW"
+	case TASK_PRIORITY_MEDIUM:
+		return "MEDIUM"
+	case TASK_PRIORITY_HIGH:
+		return "HIGH"
+	case TASK_PRIORITY_URGENT:
+		return "URGENT"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", int32(tp))
+	}
+}
+
+// MemberRole is the access level of a member. Roles are ordered: each one
+// allows everything the previous does.
+type MemberRole int32
+
 const (
-	Secret = "secret"
+	MEMBER_ROLE_UNSPECIFIED MemberRole = 0
+	MEMBER_ROLE_VIEWER      MemberRole = 1 // reads
+	MEMBER_ROLE_EDITOR      MemberRole = 2 // also changes
+	MEMBER_ROLE_OWNER       MemberRole = 3 // also deletes and manages members
 )
+
+// String returns the string representation of the MemberRole.
+func (r MemberRole) String() string {
+	switch r {
+	case MEMBER_ROLE_UNSPECIFIED:
+		return "UNSPECIFIED"
+	case MEMBER_ROLE_VIEWER:
+		return "VIEWER"
+	case MEMBER_ROLE_EDITOR:
+		return "EDITOR"
+	case MEMBER_ROLE_OWNER:
+		return "OWNER"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", int32(r))
+	}
+}
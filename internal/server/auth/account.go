@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	service "mod1/internal/services/auth"
+	taskv1 "mod1/proto/gen/go"
+)
+
+func (s *AuthServer) GetProfile(ctx context.Context, req *taskv1.GetProfileRequest) (*taskv1.GetProfileResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	user, err := s.AuthService.GetProfile(ctx, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get profile")
+	}
+
+	return &taskv1.GetProfileResponse{User: convertUserToProto(user)}, nil
+}
+
+func (s *AuthServer) UpdateProfile(ctx context.Context, req *taskv1.UpdateProfileRequest) (*taskv1.UpdateProfileResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	if req.Username == "" && req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "username or email is required")
+	}
+
+	user, err := s.AuthService.UpdateProfile(ctx, userID, req.Username, req.Email)
+	if err != nil {
+		if errors.Is(err, service.ErrUserExists) {
+			return nil, status.Error(codes.AlreadyExists, "username or email is already taken")
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to update profile")
+	}
+
+	return &taskv1.UpdateProfileResponse{User: convertUserToProto(user)}, nil
+}
+
+func (s *AuthServer) ChangePassword(ctx context.Context, req *taskv1.ChangePasswordRequest) (*taskv1.ChangePasswordResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	if req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_password is required")
+	}
+
+	confirm := confirmation(ctx, req.CurrentPassword, req.MfaCode)
+	tokens, err := s.AuthService.ChangePassword(ctx, userID, confirm, req.NewPassword, clientInfo(ctx))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCredentials) {
+			return nil, status.Error(codes.PermissionDenied, "current password is incorrect")
+		}
+		if st := confirmationStatus(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		if st := weakPasswordStatus(err, "new_password"); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "failed to change password")
+	}
+
+	return &taskv1.ChangePasswordResponse{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+func (s *AuthServer) DeleteAccount(ctx context.Context, req *taskv1.DeleteAccountRequest) (*taskv1.DeleteAccountResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.AuthService.DeleteAccount(ctx, userID, confirmation(ctx, req.Password, req.MfaCode), clientInfo(ctx)); err != nil {
+		if errors.Is(err, service.ErrInvalidCredentials) {
+			return nil, status.Error(codes.PermissionDenied, "password is incorrect")
+		}
+		if st := confirmationStatus(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to delete account")
+	}
+
+	return &taskv1.DeleteAccountResponse{Success: true}, nil
+}
+
+// confirmation collects what the caller sent to confirm an account change.
+// The token id is only known for calls made with an access token.
+func confirmation(ctx context.Context, password, mfaCode string) service.Confirmation {
+	confirm := service.Confirmation{Password: password, MfaCode: mfaCode}
+	if claims, err := claimsFromContext(ctx); err == nil {
+		confirm.TokenID = claims.ID
+	}
+	return confirm
+}
+
+// confirmationStatus maps lockouts and failures of confirming an account
+// without a password; nil means err is not one of them.
+func confirmationStatus(err error) error {
+	var lockErr *service.LockoutError
+	switch {
+	case errors.As(err, &lockErr):
+		return lockoutStatus(lockErr)
+	case errors.Is(err, service.ErrInvalidMfaCode):
+		return status.Error(codes.PermissionDenied, "invalid mfa code")
+	case errors.Is(err, service.ErrReauthRequired):
+		return status.Error(codes.FailedPrecondition, "sign in again or provide mfa_code to confirm")
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"mod1/internal/models"
+	service "mod1/internal/services/auth"
+	taskv1 "mod1/proto/gen/go"
+)
+
+func (s *AuthServer) ListUsers(ctx context.Context, req *taskv1.ListUsersRequest) (*taskv1.ListUsersResponse, error) {
+	users, err := s.AuthService.ListUsers(ctx, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list users")
+	}
+
+	protoUsers := make([]*taskv1.User, 0, len(users))
+	for _, user := range users {
+		protoUsers = append(protoUsers, convertUserToProto(user))
+	}
+
+	return &taskv1.ListUsersResponse{
+		Users:         protoUsers,
+		NextPageToken: req.PageToken + 1,
+	}, nil
+}
+
+func (s *AuthServer) DisableUser(ctx context.Context, req *taskv1.DisableUserRequest) (*taskv1.DisableUserResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	if req.UserId == userID {
+		return nil, status.Error(codes.InvalidArgument, "cannot disable own account")
+	}
+
+	if err := s.AuthService.DisableUser(ctx, req.UserId); err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to disable user")
+	}
+
+	return &taskv1.DisableUserResponse{Success: true}, nil
+}
+
+func (s *AuthServer) EnableUser(ctx context.Context, req *taskv1.EnableUserRequest) (*taskv1.EnableUserResponse, error) {
+	if err := s.AuthService.EnableUser(ctx, req.UserId); err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to enable user")
+	}
+
+	return &taskv1.EnableUserResponse{Success: true}, nil
+}
+
+// UnlockUser lifts login lockout caused by failed attempts.
+func (s *AuthServer) UnlockUser(ctx context.Context, req *taskv1.UnlockUserRequest) (*taskv1.UnlockUserResponse, error) {
+	if err := s.AuthService.UnlockUser(ctx, req.UserId); err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to unlock user")
+	}
+
+	return &taskv1.UnlockUserResponse{Success: true}, nil
+}
+
+func convertUserToProto(user models.User) *taskv1.User {
+	var createdAt *timestamppb.Timestamp
+	if !user.CreatedAt.IsZero() {
+		createdAt = timestamppb.New(user.CreatedAt)
+	}
+
+	return &taskv1.User{
+		Id:            user.ID,
+		Username:      user.Username,
+		Email:         user.Email,
+		Role:          convertRoleToProto(user.Role),
+		Disabled:      user.Disabled,
+		EmailVerified: user.EmailVerified,
+		TotpEnabled:   user.TotpEnabled,
+		CreatedAt:     createdAt,
+	}
+}
+
+func convertRoleToProto(role string) taskv1.UserRole {
+	switch role {
+	case models.RoleAdmin:
+		return taskv1.UserRole_USER_ROLE_ADMIN
+	case models.RoleMember:
+		return taskv1.UserRole_USER_ROLE_MEMBER
+	default:
+		return taskv1.UserRole_USER_ROLE_UNSPECIFIED
+	}
+}
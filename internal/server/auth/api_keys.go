@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"mod1/internal/models"
+	service "mod1/internal/services/auth"
+	taskv1 "mod1/proto/gen/go"
+	"time"
+)
+
+func (s *AuthServer) CreateApiKey(ctx context.Context, req *taskv1.CreateApiKeyRequest) (*taskv1.CreateApiKeyResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if len(req.Scopes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one scope is required")
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		if t.Before(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+		}
+		expiresAt = &t
+	}
+
+	key, apiKey, err := s.AuthService.CreateApiKey(ctx, userID, req.Name, req.Scopes, expiresAt)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidScope) {
+			return nil, status.Error(codes.InvalidArgument, "unknown scope")
+		}
+		return nil, status.Error(codes.Internal, "failed to create api key")
+	}
+
+	return &taskv1.CreateApiKeyResponse{
+		ApiKey: convertApiKeyToProto(apiKey),
+		Key:    key,
+	}, nil
+}
+
+func (s *AuthServer) ListApiKeys(ctx context.Context, req *taskv1.ListApiKeysRequest) (*taskv1.ListApiKeysResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	keys, err := s.AuthService.ListApiKeys(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list api keys")
+	}
+
+	protoKeys := make([]*taskv1.ApiKey, 0, len(keys))
+	for _, key := range keys {
+		protoKeys = append(protoKeys, convertApiKeyToProto(key))
+	}
+
+	return &taskv1.ListApiKeysResponse{ApiKeys: protoKeys}, nil
+}
+
+func (s *AuthServer) RevokeApiKey(ctx context.Context, req *taskv1.RevokeApiKeyRequest) (*taskv1.RevokeApiKeyResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.AuthService.RevokeApiKey(ctx, userID, req.Id); err != nil {
+		if errors.Is(err, service.ErrApiKeyNotFound) {
+			return nil, status.Error(codes.NotFound, "api key not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to revoke api key")
+	}
+
+	return &taskv1.RevokeApiKeyResponse{Success: true}, nil
+}
+
+func convertApiKeyToProto(key models.ApiKey) *taskv1.ApiKey {
+	var expiresAt, lastUsedAt, createdAt *timestamppb.Timestamp
+	if key.ExpiresAt != nil {
+		expiresAt = timestamppb.New(*key.ExpiresAt)
+	}
+	if key.LastUsedAt != nil {
+		lastUsedAt = timestamppb.New(*key.LastUsedAt)
+	}
+	if !key.CreatedAt.IsZero() {
+		createdAt = timestamppb.New(key.CreatedAt)
+	}
+
+	return &taskv1.ApiKey{
+		Id:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		ExpiresAt:  expiresAt,
+		LastUsedAt: lastUsedAt,
+		CreatedAt:  createdAt,
+	}
+}
@@ -3,27 +3,28 @@ package server
 import (
 	"context"
 	"errors"
-	"fmt"
-	"github.com/golang-jwt/jwt/v5"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"mod1/internal/lib/jwt"
 	service "mod1/internal/services/auth"
 	authv1 "mod1/proto/gen/go"
 	taskv1 "mod1/proto/gen/go"
 	"strings"
-	"time"
-)
-
-const (
-	tokenDuration = 1 * time.Hour
-	secretKey     = "secret"
 )
 
 type AuthServer struct {
 	authv1.UnimplementedAuthServiceServer // изменилось
 	AuthService                           *service.Auth
+	KeySet                                KeySet
+	PublicURL                             string
+}
+
+// KeySet exposes public signing keys for offline token verification.
+type KeySet interface {
+	JWKS() []jwt.JWK
+	Algorithms() []string
+	Issuer() string
 }
 
 func RegisterAuthServer(gRPCServer *grpc.Server, authService *service.Auth) {
@@ -46,6 +47,9 @@ func (s *AuthServer) Register(ctx context.Context, req *taskv1.RegisterRequest)
 		if errors.Is(err, service.ErrUserExists) {
 			return nil, status.Error(codes.AlreadyExists, "user already exists")
 		}
+		if st := weakPasswordStatus(err, "password"); st != nil {
+			return nil, st
+		}
 		return nil, status.Error(codes.Internal, "failed to register user")
 	}
 
@@ -59,89 +63,229 @@ func (s *AuthServer) Login(ctx context.Context, req *taskv1.LoginRequest) (*task
 	if req.Password == "" {
 		return nil, status.Error(codes.InvalidArgument, "password is required")
 	}
-	token, err := s.AuthService.Login(ctx, req.Email, req.Password)
+	result, err := s.AuthService.Login(ctx, req.Email, req.Password, clientInfo(ctx))
 	if err != nil {
+		var lockErr *service.LockoutError
+		if errors.As(err, &lockErr) {
+			return nil, lockoutStatus(lockErr)
+		}
 		if errors.Is(err, service.ErrInvalidCredentials) {
 			return nil, status.Error(codes.Unauthenticated, "invalid credentials")
 		}
+		if errors.Is(err, service.ErrUserDisabled) {
+			return nil, status.Error(codes.PermissionDenied, "user is disabled")
+		}
+		if errors.Is(err, service.ErrEmailNotVerified) {
+			return nil, status.Error(codes.FailedPrecondition, "email is not verified")
+		}
 		return nil, status.Error(codes.Internal, "failed to login")
 	}
 
+	return loginResponse(result), nil
+}
+
+func loginResponse(result service.LoginResult) *taskv1.LoginResponse {
+	if result.MfaToken != "" {
+		return &taskv1.LoginResponse{
+			MfaRequired: true,
+			MfaToken:    result.MfaToken,
+		}
+	}
+
 	return &taskv1.LoginResponse{
-		Token: token,
+		Token:        result.Tokens.AccessToken,
+		RefreshToken: result.Tokens.RefreshToken,
+	}
+}
+
+func (s *AuthServer) Logout(ctx context.Context, req *taskv1.LogoutRequest) (*taskv1.LogoutResponse, error) {
+	claims, err := claimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	err = s.AuthService.Logout(ctx, claims.UserID, claims.ID, claims.ExpiresAt.Time, req.RefreshToken)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to logout")
+	}
+
+	return &taskv1.LogoutResponse{Success: true}, nil
+}
+
+func (s *AuthServer) LogoutAll(ctx context.Context, req *taskv1.LogoutAllRequest) (*taskv1.LogoutAllResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.AuthService.LogoutAll(ctx, userID); err != nil {
+		return nil, status.Error(codes.Internal, "failed to logout")
+	}
+
+	return &taskv1.LogoutAllResponse{Success: true}, nil
+}
+
+func (s *AuthServer) RefreshToken(ctx context.Context, req *taskv1.RefreshTokenRequest) (*taskv1.RefreshTokenResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
+	}
+
+	tokens, err := s.AuthService.RefreshToken(ctx, req.RefreshToken, clientInfo(ctx))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidToken) {
+			return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+		}
+		if errors.Is(err, service.ErrUserDisabled) {
+			return nil, status.Error(codes.PermissionDenied, "user is disabled")
+		}
+		if errors.Is(err, service.ErrEmailNotVerified) {
+			return nil, status.Error(codes.FailedPrecondition, "email is not verified")
+		}
+		return nil, status.Error(codes.Internal, "failed to refresh token")
+	}
+
+	return &taskv1.RefreshTokenResponse{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 	}, nil
 }
 
-// Функция для создания JWT токена
-/*func createToken(userID int64) (string, error) {
-	claims := jwt.MapClaims{
-		"user_id": userID,
-		"exp":     time.Now().Add(tokenDuration).Unix(),
+func (s *AuthServer) RequestPasswordReset(ctx context.Context, req *taskv1.RequestPasswordResetRequest) (*taskv1.RequestPasswordResetResponse, error) {
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(secretKey))
-}*/
+	if err := s.AuthService.RequestPasswordReset(ctx, req.Email); err != nil {
+		return nil, status.Error(codes.Internal, "failed to request password reset")
+	}
 
-// Функция для проверки JWT токена
-func verifyToken(tokenString string) (int64, error) {
+	return &taskv1.RequestPasswordResetResponse{Success: true}, nil
+}
 
-	if tokenString == "" {
-		return 0, fmt.Errorf("empty token string")
+func (s *AuthServer) ResetPassword(ctx context.Context, req *taskv1.ResetPasswordRequest) (*taskv1.ResetPasswordResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
 	}
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	if req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_password is required")
+	}
+
+	if err := s.AuthService.ResetPassword(ctx, req.Token, req.NewPassword); err != nil {
+		if errors.Is(err, service.ErrInvalidResetToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid or expired reset token")
 		}
-		return []byte(secretKey), nil
-	})
-	if err != nil {
-		return 0, fmt.Errorf("token parsing failed: %w", err)
+		if st := weakPasswordStatus(err, "new_password"); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "failed to reset password")
 	}
 
-	if !token.Valid {
-		return 0, fmt.Errorf("invalid token")
+	return &taskv1.ResetPasswordResponse{Success: true}, nil
+}
+
+func (s *AuthServer) VerifyEmail(ctx context.Context, req *taskv1.VerifyEmailRequest) (*taskv1.VerifyEmailResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
 	}
 
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return 0, fmt.Errorf("invalid token claims format")
+	if err := s.AuthService.VerifyEmail(ctx, req.Token); err != nil {
+		if errors.Is(err, service.ErrInvalidVerificationToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid or expired verification token")
+		}
+		return nil, status.Error(codes.Internal, "failed to verify email")
 	}
 
-	userIDClaim, exists := claims["user_id"]
-	if !exists {
-		return 0, fmt.Errorf("user_id claim is missing")
+	return &taskv1.VerifyEmailResponse{Success: true}, nil
+}
+
+func (s *AuthServer) ResendVerification(ctx context.Context, req *taskv1.ResendVerificationRequest) (*taskv1.ResendVerificationResponse, error) {
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
 	}
 
-	var userID int64
-	switch v := userIDClaim.(type) {
-	case float64:
-		userID = int64(v)
-	case int64:
-		userID = v
-	default:
-		return 0, fmt.Errorf("user_id must be a number, got %T", v)
+	if err := s.AuthService.ResendVerification(ctx, req.Email); err != nil {
+		return nil, status.Error(codes.Internal, "failed to send verification email")
 	}
-	fmt.Println("token valid")
-	return userID, nil
+
+	return &taskv1.ResendVerificationResponse{Success: true}, nil
 }
 
-// Функция для извлечения userID из контекста
-func GetUserIDFromContext(ctx context.Context) (int64, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
+func (s *AuthServer) GetJWKS(ctx context.Context, req *taskv1.GetJWKSRequest) (*taskv1.GetJWKSResponse, error) {
+	jwks := s.KeySet.JWKS()
+
+	keys := make([]*taskv1.JsonWebKey, 0, len(jwks))
+	for _, k := range jwks {
+		keys = append(keys, &taskv1.JsonWebKey{
+			Kid: k.Kid,
+			Kty: k.Kty,
+			Alg: k.Alg,
+			Use: k.Use,
+			N:   k.N,
+			E:   k.E,
+			Crv: k.Crv,
+			X:   k.X,
+			Y:   k.Y,
+		})
+	}
+
+	return &taskv1.GetJWKSResponse{Keys: keys}, nil
+}
+
+func (s *AuthServer) GetDiscoveryDocument(ctx context.Context, req *taskv1.GetDiscoveryDocumentRequest) (*taskv1.GetDiscoveryDocumentResponse, error) {
+	baseURL := strings.TrimSuffix(s.PublicURL, "/")
+
+	return &taskv1.GetDiscoveryDocumentResponse{
+		Issuer:                           s.KeySet.Issuer(),
+		JwksUri:                          baseURL + "/.well-known/jwks.json",
+		TokenEndpoint:                    baseURL + "/v1/auth/login",
+		RevocationEndpoint:               baseURL + "/v1/auth/logout",
+		IdTokenSigningAlgValuesSupported: s.KeySet.Algorithms(),
+		GrantTypesSupported:              []string{"password", "refresh_token"},
+	}, nil
+}
+
+type ctxKey struct{}
+
+// principal описывает аутентифицированного пользователя запроса
+type principal struct {
+	UserID int64
+	Role   string
+	Claims *jwt.Claims // nil при аутентификации по API ключу
+	// Restricted означает, что доступны только методы из Scopes
+	// (API ключи и токены пользователей с неподтвержденным email)
+	Restricted bool
+	Scopes     []string
+}
+
+// Функция для извлечения principal, сохраненного AuthInterceptor
+func principalFromContext(ctx context.Context) (*principal, error) {
+	p, ok := ctx.Value(ctxKey{}).(*principal)
 	if !ok {
-		return 0, errors.New("no metadata in context")
+		return nil, errors.New("no principal in context")
 	}
 
-	authHeader := md.Get("authorization")
-	if len(authHeader) == 0 {
-		return 0, errors.New("no authorization header")
+	return p, nil
+}
+
+// Функция для извлечения claims JWT токена
+func claimsFromContext(ctx context.Context) (*jwt.Claims, error) {
+	p, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if p.Claims == nil {
+		return nil, errors.New("request is not authenticated with token")
 	}
 
-	tokenString := strings.TrimPrefix(authHeader[0], "Bearer ")
-	if tokenString == authHeader[0] {
-		return 0, errors.New("invalid authorization format")
+	return p.Claims, nil
+}
+
+// Функция для извлечения userID из контекста
+func GetUserIDFromContext(ctx context.Context) (int64, error) {
+	p, err := principalFromContext(ctx)
+	if err != nil {
+		return 0, err
 	}
 
-	return verifyToken(tokenString)
+	return p.UserID, nil
 }
@@ -2,7 +2,11 @@ package server
 
 import (
 	"context"
+	"errors"
 	"log"
+	"mod1/internal/lib/jwt"
+	"mod1/internal/models"
+	service "mod1/internal/services/auth"
 	"strings"
 
 	"google.golang.org/grpc"
@@ -11,45 +15,106 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-var publicMethods = map[string]bool{
-	"/task_service.TaskService/Login":    true,
-	"/task_service.TaskService/Register": true,
+// TokenChecker reports whether a token with valid signature is still accepted,
+// i.e. it was not revoked by Logout or LogoutAll.
+type TokenChecker interface {
+	ValidateToken(ctx context.Context, userID int64, jti string, generation int64) error
 }
 
-func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	log.Printf("Incoming call: %s", info.FullMethod)
+// TokenVerifier checks token signature and returns its claims.
+type TokenVerifier interface {
+	Verify(tokenString string) (*jwt.Claims, error)
+}
 
-	publicMethods := map[string]bool{
-		"/task_service.AuthService/Login":    true,
-		"/task_service.AuthService/Register": true,
-	}
+// ApiKeyAuthenticator resolves personal API keys to their owners.
+type ApiKeyAuthenticator interface {
+	AuthenticateApiKey(ctx context.Context, key string) (models.User, models.ApiKey, error)
+}
+
+func AuthInterceptor(verifier TokenVerifier, checker TokenChecker, apiKeys ApiKeyAuthenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		log.Printf("Incoming call: %s", info.FullMethod)
+
+		perm, ok := methodPermissions[info.FullMethod]
+		if !ok {
+			log.Printf("Method %s is not in permission table", info.FullMethod)
+			return nil, status.Error(codes.PermissionDenied, "method is not allowed")
+		}
+
+		if perm.public {
+			log.Printf("Public method %s, skipping auth", info.FullMethod)
+			return handler(ctx, req)
+		}
+
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
+		}
+
+		authHeader := md.Get("authorization")
+		if len(authHeader) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
+		}
+
+		var p *principal
+		var err error
+		switch {
+		case strings.HasPrefix(authHeader[0], "Bearer "):
+			p, err = authenticateBearer(ctx, verifier, checker, strings.TrimPrefix(authHeader[0], "Bearer "))
+		case strings.HasPrefix(authHeader[0], "ApiKey "):
+			p, err = authenticateApiKey(ctx, apiKeys, strings.TrimPrefix(authHeader[0], "ApiKey "))
+		default:
+			return nil, status.Error(codes.Unauthenticated, "invalid authorization format")
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !perm.allows(p) {
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}
+
+		ctx = context.WithValue(ctx, ctxKey{}, p)
 
-	if publicMethods[info.FullMethod] {
-		log.Printf("Public method %s, skipping auth", info.FullMethod)
 		return handler(ctx, req)
 	}
+}
 
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
+func authenticateBearer(ctx context.Context, verifier TokenVerifier, checker TokenChecker, token string) (*principal, error) {
+	claims, err := verifier.Verify(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
 	}
 
-	authHeader := md.Get("authorization")
-	if len(authHeader) == 0 {
-		return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
+	if err := checker.ValidateToken(ctx, claims.UserID, claims.ID, claims.Generation); err != nil {
+		if errors.Is(err, service.ErrTokenRevoked) {
+			return nil, status.Error(codes.Unauthenticated, "token has been revoked")
+		}
+		return nil, status.Error(codes.Internal, "failed to validate token")
 	}
 
-	token := strings.TrimPrefix(authHeader[0], "Bearer ")
-	if token == authHeader[0] {
-		return nil, status.Error(codes.Unauthenticated, "invalid authorization format")
-	}
+	return &principal{
+		UserID:     claims.UserID,
+		Role:       claims.Role,
+		Claims:     claims,
+		Restricted: claims.Scope != "",
+		Scopes:     strings.Fields(claims.Scope),
+	}, nil
+}
 
-	userID, err := verifyToken(token)
+func authenticateApiKey(ctx context.Context, apiKeys ApiKeyAuthenticator, key string) (*principal, error) {
+	user, apiKey, err := apiKeys.AuthenticateApiKey(ctx, key)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, "invalid token")
+		if errors.Is(err, service.ErrInvalidApiKey) {
+			return nil, status.Error(codes.Unauthenticated, "invalid api key")
+		}
+		return nil, status.Error(codes.Internal, "failed to validate api key")
 	}
 
-	ctx = context.WithValue(ctx, "userID", userID)
-
-	return handler(ctx, req)
+	return &principal{
+		UserID:     user.ID,
+		Role:       user.Role,
+		Restricted: true,
+		Scopes:     apiKey.Scopes,
+	}, nil
 }
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	service "mod1/internal/services/auth"
+	"net"
+	"strings"
+)
+
+// GatewayMetadataKey carries the secret the REST gateway attaches to every
+// call it proxies, see GatewayInterceptor.
+const GatewayMetadataKey = "x-gateway-secret"
+
+type gatewayCallKey struct{}
+
+// GatewayInterceptor marks calls that carry secret in GatewayMetadataKey as
+// proxied by the REST gateway; only for them x-forwarded-for is trusted.
+// The key is removed from the metadata of every call before it reaches
+// other interceptors and handlers.
+func GatewayInterceptor(secret string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok || len(md.Get(GatewayMetadataKey)) == 0 {
+			return handler(ctx, req)
+		}
+
+		fromGateway := false
+		for _, v := range md.Get(GatewayMetadataKey) {
+			if subtle.ConstantTimeCompare([]byte(v), []byte(secret)) == 1 {
+				fromGateway = true
+			}
+		}
+
+		md = md.Copy()
+		md.Delete(GatewayMetadataKey)
+		ctx = metadata.NewIncomingContext(ctx, md)
+		if fromGateway {
+			ctx = context.WithValue(ctx, gatewayCallKey{}, true)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func isGatewayCall(ctx context.Context) bool {
+	v, _ := ctx.Value(gatewayCallKey{}).(bool)
+	return v
+}
+
+// clientInfo describes the caller for session records and brute-force protection.
+func clientInfo(ctx context.Context) service.ClientInfo {
+	return service.ClientInfo{
+		IP:        clientIP(ctx),
+		UserAgent: userAgent(ctx),
+	}
+}
+
+// clientIP returns address of the caller. For requests proxied by the REST
+// gateway (see GatewayInterceptor) the last x-forwarded-for entry, added by
+// the gateway itself, is used instead of the gateway's own address.
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	if !isGatewayCall(ctx) {
+		return host
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return host
+	}
+
+	forwarded := md.Get("x-forwarded-for")
+	if len(forwarded) == 0 {
+		return host
+	}
+
+	hops := strings.Split(forwarded[len(forwarded)-1], ",")
+	if last := strings.TrimSpace(hops[len(hops)-1]); last != "" {
+		return last
+	}
+
+	return host
+}
+
+// userAgent prefers the HTTP User-Agent forwarded by the REST gateway over
+// the one of the gRPC client library.
+func userAgent(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, key := range []string{"grpcgateway-user-agent", "user-agent"} {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,28 @@
+package server
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	service "mod1/internal/services/auth"
+	"time"
+)
+
+// lockoutStatus builds ResourceExhausted status with RetryInfo, so clients
+// know when to try again.
+func lockoutStatus(lockErr *service.LockoutError) error {
+	st := status.New(codes.ResourceExhausted, "too many failed login attempts, try again later")
+
+	retry := time.Until(lockErr.Until).Round(time.Second)
+	if retry < time.Second {
+		retry = time.Second
+	}
+
+	withDetails, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retry)})
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
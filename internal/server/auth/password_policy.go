@@ -0,0 +1,37 @@
+package server
+
+import (
+	"errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	service "mod1/internal/services/auth"
+)
+
+// weakPasswordStatus maps PasswordPolicyError to InvalidArgument with a
+// BadRequest detail holding one field violation per broken rule.
+// It returns nil if err is not a policy error.
+func weakPasswordStatus(err error, field string) error {
+	var policyErr *service.PasswordPolicyError
+	if !errors.As(err, &policyErr) {
+		return nil
+	}
+
+	st := status.New(codes.InvalidArgument, "password does not meet the password policy")
+
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range policyErr.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: field + " " + v.Description,
+			Reason:      v.Reason,
+		})
+	}
+
+	withDetails, err := st.WithDetails(badRequest)
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
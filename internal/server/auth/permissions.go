@@ -0,0 +1,105 @@
+package server
+
+import (
+	"mod1/internal/models"
+	taskv1 "mod1/proto/gen/go"
+)
+
+// permission describes who may call a gRPC method.
+type permission struct {
+	public bool     // no authentication required
+	roles  []string // roles allowed to call the method
+	scope  string   // scope required from restricted callers; methods without scope reject them
+}
+
+var (
+	allRoles   = []string{models.RoleMember, models.RoleAdmin}
+	adminRoles = []string{models.RoleAdmin}
+)
+
+var (
+	publicAccess = permission{public: true}
+	anyRole      = permission{roles: allRoles}
+	adminOnly    = permission{roles: adminRoles}
+	tasksRead    = permission{roles: allRoles, scope: models.ScopeTasksRead}
+	tasksWrite   = permission{roles: allRoles, scope: models.ScopeTasksWrite}
+	account      = permission{roles: allRoles, scope: models.ScopeAccount}
+)
+
+// methodPermissions is consulted by AuthInterceptor.
+// Methods missing from the table are rejected.
+var methodPermissions = map[string]permission{
+	taskv1.AuthService_Register_FullMethodName:             publicAccess,
+	taskv1.AuthService_Login_FullMethodName:                publicAccess,
+	taskv1.AuthService_RefreshToken_FullMethodName:         publicAccess,
+	taskv1.AuthService_GetJWKS_FullMethodName:              publicAccess,
+	taskv1.AuthService_GetDiscoveryDocument_FullMethodName: publicAccess,
+	taskv1.AuthService_RequestPasswordReset_FullMethodName: publicAccess,
+	taskv1.AuthService_ResetPassword_FullMethodName:        publicAccess,
+	taskv1.AuthService_VerifyEmail_FullMethodName:          publicAccess,
+	taskv1.AuthService_ResendVerification_FullMethodName:   publicAccess,
+	taskv1.AuthService_VerifyMfa_FullMethodName:            publicAccess,
+	taskv1.AuthService_StartSso_FullMethodName:             publicAccess,
+	taskv1.AuthService_CompleteSso_FullMethodName:          publicAccess,
+	taskv1.AuthService_GetProfile_FullMethodName:           account,
+	taskv1.AuthService_UpdateProfile_FullMethodName:        account,
+	taskv1.AuthService_ChangePassword_FullMethodName:       account,
+	taskv1.AuthService_DeleteAccount_FullMethodName:        account,
+	taskv1.AuthService_ListSessions_FullMethodName:         account,
+	taskv1.AuthService_RevokeSession_FullMethodName:        account,
+	taskv1.AuthService_EnrollTotp_FullMethodName:           account,
+	taskv1.AuthService_ConfirmTotp_FullMethodName:          account,
+	taskv1.AuthService_DisableTotp_FullMethodName:          account,
+	taskv1.AuthService_Logout_FullMethodName:               account,
+	taskv1.AuthService_LogoutAll_FullMethodName:            account,
+	taskv1.AuthService_ListUsers_FullMethodName:            adminOnly,
+	taskv1.AuthService_DisableUser_FullMethodName:          adminOnly,
+	taskv1.AuthService_EnableUser_FullMethodName:           adminOnly,
+	taskv1.AuthService_UnlockUser_FullMethodName:           adminOnly,
+	taskv1.AuthService_CreateApiKey_FullMethodName:         anyRole,
+	taskv1.AuthService_ListApiKeys_FullMethodName:          anyRole,
+	taskv1.AuthService_RevokeApiKey_FullMethodName:         anyRole,
+
+	taskv1.TaskService_CreateTask_FullMethodName:         tasksWrite,
+	taskv1.TaskService_GetTask_FullMethodName:            tasksRead,
+	taskv1.TaskService_UpdateTask_FullMethodName:         tasksWrite,
+	taskv1.TaskService_DeleteTask_FullMethodName:         tasksWrite,
+	taskv1.TaskService_ListTasks_FullMethodName:          tasksRead,
+	taskv1.TaskService_ListSubtasks_FullMethodName:       tasksRead,
+	taskv1.TaskService_SearchTasks_FullMethodName:        tasksRead,
+	taskv1.TaskService_CreateTag_FullMethodName:          tasksWrite,
+	taskv1.TaskService_ListTags_FullMethodName:           tasksRead,
+	taskv1.TaskService_RenameTag_FullMethodName:          tasksWrite,
+	taskv1.TaskService_DeleteTag_FullMethodName:          tasksWrite,
+	taskv1.TaskService_CreateProject_FullMethodName:      tasksWrite,
+	taskv1.TaskService_GetProject_FullMethodName:         tasksRead,
+	taskv1.TaskService_ListProjects_FullMethodName:       tasksRead,
+	taskv1.TaskService_UpdateProject_FullMethodName:      tasksWrite,
+	taskv1.TaskService_DeleteProject_FullMethodName:      tasksWrite,
+	taskv1.TaskService_MoveTask_FullMethodName:           tasksWrite,
+	taskv1.TaskService_AddDependency_FullMethodName:      tasksWrite,
+	taskv1.TaskService_RemoveDependency_FullMethodName:   tasksWrite,
+	taskv1.TaskService_ShareTask_FullMethodName:          tasksWrite,
+	taskv1.TaskService_UnshareTask_FullMethodName:        tasksWrite,
+	taskv1.TaskService_ListTaskMembers_FullMethodName:    tasksRead,
+	taskv1.TaskService_ShareProject_FullMethodName:       tasksWrite,
+	taskv1.TaskService_UnshareProject_FullMethodName:     tasksWrite,
+	taskv1.TaskService_ListProjectMembers_FullMethodName: tasksRead,
+	taskv1.TaskService_ListUserTasks_FullMethodName:      adminOnly,
+}
+
+func (p permission) allows(caller *principal) bool {
+	if caller.Restricted && (p.scope == "" || !contains(caller.Scopes, p.scope)) {
+		return false
+	}
+	return contains(p.roles, caller.Role)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"mod1/internal/models"
+	taskv1 "mod1/proto/gen/go"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+// Every RPC has to be listed: AuthInterceptor rejects unknown methods, so a
+// missing entry makes a new RPC unreachable.
+func TestMethodPermissionsCoverAllMethods(t *testing.T) {
+	for _, desc := range []grpc.ServiceDesc{taskv1.AuthService_ServiceDesc, taskv1.TaskService_ServiceDesc} {
+		for _, m := range desc.Methods {
+			method := "/" + desc.ServiceName + "/" + m.MethodName
+			if _, ok := methodPermissions[method]; !ok {
+				t.Errorf("%s is not in the permission table", method)
+			}
+		}
+	}
+}
+
+func TestPermissionAllows(t *testing.T) {
+	member := &principal{Role: models.RoleMember}
+	admin := &principal{Role: models.RoleAdmin}
+	readKey := &principal{Role: models.RoleMember, Restricted: true, Scopes: []string{models.ScopeTasksRead}}
+	adminReadKey := &principal{Role: models.RoleAdmin, Restricted: true, Scopes: []string{models.ScopeTasksRead}}
+	accountKey := &principal{Role: models.RoleMember, Restricted: true, Scopes: []string{models.ScopeAccount}}
+
+	tests := []struct {
+		name   string
+		method string
+		caller *principal
+		want   bool
+	}{
+		{name: "member reads tasks", method: taskv1.TaskService_GetTask_FullMethodName, caller: member, want: true},
+		{name: "member writes tasks", method: taskv1.TaskService_CreateTask_FullMethodName, caller: member, want: true},
+		{name: "member lists users", method: taskv1.AuthService_ListUsers_FullMethodName, caller: member, want: false},
+		{name: "member lists tasks of another user", method: taskv1.TaskService_ListUserTasks_FullMethodName, caller: member, want: false},
+		{name: "admin lists users", method: taskv1.AuthService_ListUsers_FullMethodName, caller: admin, want: true},
+		{name: "admin unlocks user", method: taskv1.AuthService_UnlockUser_FullMethodName, caller: admin, want: true},
+		{name: "read key reads tasks", method: taskv1.TaskService_ListTasks_FullMethodName, caller: readKey, want: true},
+		{name: "read key writes tasks", method: taskv1.TaskService_UpdateTask_FullMethodName, caller: readKey, want: false},
+		{name: "read key shares task", method: taskv1.TaskService_ShareTask_FullMethodName, caller: readKey, want: false},
+		{name: "read key deletes account", method: taskv1.AuthService_DeleteAccount_FullMethodName, caller: readKey, want: false},
+		{name: "read key creates api key", method: taskv1.AuthService_CreateApiKey_FullMethodName, caller: readKey, want: false},
+		{name: "admin read key lists users", method: taskv1.AuthService_ListUsers_FullMethodName, caller: adminReadKey, want: false},
+		{name: "account key changes password", method: taskv1.AuthService_ChangePassword_FullMethodName, caller: accountKey, want: true},
+		{name: "account key reads tasks", method: taskv1.TaskService_GetTask_FullMethodName, caller: accountKey, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perm, ok := methodPermissions[tt.method]
+			if !ok {
+				t.Fatalf("%s is not in the permission table", tt.method)
+			}
+			if perm.public {
+				t.Fatalf("%s is public", tt.method)
+			}
+			if got := perm.allows(tt.caller); got != tt.want {
+				t.Errorf("allows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPublicMethods(t *testing.T) {
+	public := map[string]bool{
+		taskv1.AuthService_Register_FullMethodName:             true,
+		taskv1.AuthService_Login_FullMethodName:                true,
+		taskv1.AuthService_RefreshToken_FullMethodName:         true,
+		taskv1.AuthService_GetJWKS_FullMethodName:              true,
+		taskv1.AuthService_GetDiscoveryDocument_FullMethodName: true,
+		taskv1.AuthService_RequestPasswordReset_FullMethodName: true,
+		taskv1.AuthService_ResetPassword_FullMethodName:        true,
+		taskv1.AuthService_VerifyEmail_FullMethodName:          true,
+		taskv1.AuthService_ResendVerification_FullMethodName:   true,
+		taskv1.AuthService_VerifyMfa_FullMethodName:            true,
+		taskv1.AuthService_StartSso_FullMethodName:             true,
+		taskv1.AuthService_CompleteSso_FullMethodName:          true,
+	}
+
+	for method, perm := range methodPermissions {
+		if perm.public != public[method] {
+			t.Errorf("%s: public = %v, want %v", method, perm.public, public[method])
+		}
+	}
+}
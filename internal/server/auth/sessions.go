@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"mod1/internal/models"
+	service "mod1/internal/services/auth"
+	taskv1 "mod1/proto/gen/go"
+)
+
+func (s *AuthServer) ListSessions(ctx context.Context, req *taskv1.ListSessionsRequest) (*taskv1.ListSessionsResponse, error) {
+	claims, err := claimsFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	sessions, err := s.AuthService.ListSessions(ctx, claims.UserID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list sessions")
+	}
+
+	protoSessions := make([]*taskv1.Session, 0, len(sessions))
+	for _, session := range sessions {
+		protoSession := convertSessionToProto(session)
+		protoSession.Current = session.AccessJTI == claims.ID
+		protoSessions = append(protoSessions, protoSession)
+	}
+
+	return &taskv1.ListSessionsResponse{Sessions: protoSessions}, nil
+}
+
+func (s *AuthServer) RevokeSession(ctx context.Context, req *taskv1.RevokeSessionRequest) (*taskv1.RevokeSessionResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	if req.SessionId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+
+	if err := s.AuthService.RevokeSession(ctx, userID, req.SessionId); err != nil {
+		if errors.Is(err, service.ErrSessionNotFound) {
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to revoke session")
+	}
+
+	return &taskv1.RevokeSessionResponse{Success: true}, nil
+}
+
+func convertSessionToProto(session models.Session) *taskv1.Session {
+	return &taskv1.Session{
+		Id:         session.ID,
+		UserAgent:  session.UserAgent,
+		Ip:         session.IP,
+		CreatedAt:  timestamppb.New(session.CreatedAt),
+		LastSeenAt: timestamppb.New(session.LastSeenAt),
+	}
+}
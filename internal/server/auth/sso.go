@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	service "mod1/internal/services/auth"
+	taskv1 "mod1/proto/gen/go"
+)
+
+func (s *AuthServer) StartSso(ctx context.Context, req *taskv1.StartSsoRequest) (*taskv1.StartSsoResponse, error) {
+	authURL, err := s.AuthService.StartSso(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrSsoDisabled) {
+			return nil, status.Error(codes.Unimplemented, "sso is not configured")
+		}
+		return nil, status.Error(codes.Unavailable, "failed to contact identity provider")
+	}
+
+	return &taskv1.StartSsoResponse{AuthorizationUrl: authURL}, nil
+}
+
+func (s *AuthServer) CompleteSso(ctx context.Context, req *taskv1.CompleteSsoRequest) (*taskv1.LoginResponse, error) {
+	if req.Error != "" {
+		return nil, status.Errorf(codes.PermissionDenied, "identity provider returned %s: %s", req.Error, req.ErrorDescription)
+	}
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+	if req.State == "" {
+		return nil, status.Error(codes.InvalidArgument, "state is required")
+	}
+
+	result, err := s.AuthService.CompleteSso(ctx, req.Code, req.State, clientInfo(ctx))
+	if err != nil {
+		if errors.Is(err, service.ErrSsoDisabled) {
+			return nil, status.Error(codes.Unimplemented, "sso is not configured")
+		}
+		if errors.Is(err, service.ErrInvalidSsoState) {
+			return nil, status.Error(codes.InvalidArgument, "invalid or expired state, start sso again")
+		}
+		if errors.Is(err, service.ErrSsoRejected) {
+			return nil, status.Error(codes.Unauthenticated, "identity provider response was rejected")
+		}
+		if errors.Is(err, service.ErrSsoLinkUnverified) {
+			return nil, status.Error(codes.FailedPrecondition, "verify the email of the existing account before signing in with sso")
+		}
+		if errors.Is(err, service.ErrSsoNoAccount) {
+			return nil, status.Error(codes.PermissionDenied, "no account is linked to this identity")
+		}
+		if errors.Is(err, service.ErrUserDisabled) {
+			return nil, status.Error(codes.PermissionDenied, "user is disabled")
+		}
+		if errors.Is(err, service.ErrEmailNotVerified) {
+			return nil, status.Error(codes.FailedPrecondition, "email is not verified")
+		}
+		return nil, status.Error(codes.Internal, "failed to complete sso login")
+	}
+
+	return loginResponse(result), nil
+}
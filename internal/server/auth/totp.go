@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	service "mod1/internal/services/auth"
+	taskv1 "mod1/proto/gen/go"
+)
+
+func (s *AuthServer) VerifyMfa(ctx context.Context, req *taskv1.VerifyMfaRequest) (*taskv1.VerifyMfaResponse, error) {
+	if req.MfaToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "mfa_token is required")
+	}
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	tokens, err := s.AuthService.VerifyMfa(ctx, req.MfaToken, req.Code, clientInfo(ctx))
+	if err != nil {
+		var lockErr *service.LockoutError
+		if errors.As(err, &lockErr) {
+			return nil, lockoutStatus(lockErr)
+		}
+		if errors.Is(err, service.ErrInvalidMfaToken) {
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired mfa token")
+		}
+		if errors.Is(err, service.ErrInvalidMfaCode) {
+			return nil, status.Error(codes.Unauthenticated, "invalid mfa code")
+		}
+		if errors.Is(err, service.ErrUserDisabled) {
+			return nil, status.Error(codes.PermissionDenied, "user is disabled")
+		}
+		return nil, status.Error(codes.Internal, "failed to verify mfa code")
+	}
+
+	return &taskv1.VerifyMfaResponse{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+func (s *AuthServer) EnrollTotp(ctx context.Context, req *taskv1.EnrollTotpRequest) (*taskv1.EnrollTotpResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	enrollment, err := s.AuthService.EnrollTotp(ctx, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrTotpAlreadyEnabled) {
+			return nil, status.Error(codes.FailedPrecondition, "totp is already enabled")
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to enroll totp")
+	}
+
+	return &taskv1.EnrollTotpResponse{
+		Secret:     enrollment.Secret,
+		OtpauthUri: enrollment.URI,
+	}, nil
+}
+
+func (s *AuthServer) ConfirmTotp(ctx context.Context, req *taskv1.ConfirmTotpRequest) (*taskv1.ConfirmTotpResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	recoveryCodes, err := s.AuthService.ConfirmTotp(ctx, userID, req.Code)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidMfaCode) {
+			return nil, status.Error(codes.InvalidArgument, "invalid totp code")
+		}
+		if errors.Is(err, service.ErrTotpAlreadyEnabled) {
+			return nil, status.Error(codes.FailedPrecondition, "totp is already enabled")
+		}
+		if errors.Is(err, service.ErrTotpNotEnrolled) {
+			return nil, status.Error(codes.FailedPrecondition, "call EnrollTotp first")
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to confirm totp")
+	}
+
+	return &taskv1.ConfirmTotpResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (s *AuthServer) DisableTotp(ctx context.Context, req *taskv1.DisableTotpRequest) (*taskv1.DisableTotpResponse, error) {
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	if err := s.AuthService.DisableTotp(ctx, userID, req.Code, clientInfo(ctx)); err != nil {
+		var lockErr *service.LockoutError
+		if errors.As(err, &lockErr) {
+			return nil, lockoutStatus(lockErr)
+		}
+		if errors.Is(err, service.ErrInvalidMfaCode) {
+			return nil, status.Error(codes.InvalidArgument, "invalid mfa code")
+		}
+		if errors.Is(err, service.ErrTotpNotEnabled) {
+			return nil, status.Error(codes.FailedPrecondition, "totp is not enabled")
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to disable totp")
+	}
+
+	return &taskv1.DisableTotpResponse{Success: true}, nil
+}
@@ -0,0 +1,66 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	authserver "mod1/internal/server/auth"
+	taskv1 "mod1/proto/gen/go"
+)
+
+// New creates HTTP server that proxies REST/JSON requests to the gRPC server on grpcAddr.
+// Calls go through the gRPC listener, so AuthInterceptor applies to them as well;
+// the Authorization header is forwarded as "authorization" metadata by the gateway.
+// secret is attached to every call so that the gRPC server can tell proxied
+// calls from direct ones and trust x-forwarded-for only for the former.
+func New(ctx context.Context, grpcAddr, restAddr, secret string) (*http.Server, error) {
+	const op = "gateway.New"
+
+	// Field names are kept as in proto (snake_case) and empty fields are
+	// omitted, so /.well-known documents come out as OIDC and JWKS expect.
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithMetadata(func(context.Context, *http.Request) metadata.MD {
+			return metadata.Pairs(authserver.GatewayMetadataKey, secret)
+		}),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				EmitUnpopulated: false,
+				UseProtoNames:   true,
+			},
+		}),
+	)
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+	if err := taskv1.RegisterAuthServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		return nil, fmt.Errorf("%s: register auth handler: %w", op, err)
+	}
+	if err := taskv1.RegisterTaskServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		return nil, fmt.Errorf("%s: register task handler: %w", op, err)
+	}
+
+	return &http.Server{
+		Addr:    restAddr,
+		Handler: mux,
+	}, nil
+}
+
+// headerMatcher forwards Idempotency-Key in addition to the headers
+// forwarded by default. The gateway secret is never taken from the client.
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, "Idempotency-Key") {
+		return "idempotency-key", true
+	}
+	if strings.EqualFold(strings.TrimPrefix(strings.ToLower(key), "grpc-metadata-"), authserver.GatewayMetadataKey) {
+		return "", false
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	auth "mod1/internal/server/auth"
+	taskv1 "mod1/proto/gen/go"
+)
+
+func (s *TaskServer) AddDependency(ctx context.Context, req *taskv1.AddDependencyRequest) (*taskv1.AddDependencyResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.Service.AddDependency(ctx, userID, req.TaskId, req.BlockedById); err != nil {
+		return nil, taskStatus(err, "failed to add dependency")
+	}
+
+	task, err := s.Service.GetTask(ctx, userID, req.TaskId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get task")
+	}
+
+	return &taskv1.AddDependencyResponse{Task: convertTaskToProto(task)}, nil
+}
+
+func (s *TaskServer) RemoveDependency(ctx context.Context, req *taskv1.RemoveDependencyRequest) (*taskv1.RemoveDependencyResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.Service.RemoveDependency(ctx, userID, req.TaskId, req.BlockedById); err != nil {
+		return nil, taskStatus(err, "failed to remove dependency")
+	}
+
+	task, err := s.Service.GetTask(ctx, userID, req.TaskId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get task")
+	}
+
+	return &taskv1.RemoveDependencyResponse{Task: convertTaskToProto(task)}, nil
+}
@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"log/slog"
+	sl "mod1/internal/lib/logger"
+	"mod1/internal/models"
+	auth "mod1/internal/server/auth"
+	taskv1 "mod1/proto/gen/go"
+	"time"
+)
+
+const (
+	// IdempotencyKeyHeader is the metadata key (and HTTP header) clients set
+	// to make retries of a mutating call safe.
+	IdempotencyKeyHeader = "idempotency-key"
+	// idempotentReplayHeader is set on responses replayed from a previous call.
+	idempotentReplayHeader = "idempotent-replayed"
+
+	maxIdempotencyKeyLen = 255
+)
+
+// idempotentMethods lists RPCs that honour the idempotency key.
+var idempotentMethods = map[string]bool{
+	taskv1.TaskService_CreateTask_FullMethodName:    true,
+	taskv1.TaskService_UpdateTask_FullMethodName:    true,
+	taskv1.TaskService_DeleteTask_FullMethodName:    true,
+	taskv1.TaskService_CreateTag_FullMethodName:     true,
+	taskv1.TaskService_CreateProject_FullMethodName: true,
+	taskv1.TaskService_MoveTask_FullMethodName:      true,
+	taskv1.TaskService_AddDependency_FullMethodName: true,
+}
+
+// IdempotencyStore keeps responses of calls made with an idempotency key.
+type IdempotencyStore interface {
+	BeginIdempotentRequest(ctx context.Context, userID int64, key, method, requestHash string, expiresAt time.Time) (models.IdempotencyKey, bool, error)
+	CompleteIdempotentRequest(ctx context.Context, userID int64, key string, response []byte) error
+	ReleaseIdempotentRequest(ctx context.Context, userID int64, key string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context) error
+}
+
+// IdempotencyInterceptor makes calls of idempotentMethods that carry an
+// idempotency key run at most once per user and key within ttl: a retry
+// gets the stored response of the first call. Failed calls are not
+// remembered, so they can be retried with the same key. It has to run
+// after AuthInterceptor.
+func IdempotencyInterceptor(store IdempotencyStore, ttl time.Duration, log *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !idempotentMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		key := idempotencyKey(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+		if len(key) > maxIdempotencyKeyLen {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key must be at most %d characters", maxIdempotencyKeyLen)
+		}
+
+		userID, err := auth.GetUserIDFromContext(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "unauthorized")
+		}
+
+		log := log.With(
+			slog.String("method", info.FullMethod),
+			slog.Int64("user_id", userID),
+		)
+
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		requestHash, err := hashRequest(msg)
+		if err != nil {
+			log.Error("failed to hash request", sl.Err(err))
+			return nil, status.Error(codes.Internal, "failed to process idempotency key")
+		}
+
+		stored, started, err := store.BeginIdempotentRequest(ctx, userID, key, info.FullMethod, requestHash, time.Now().Add(ttl))
+		if err != nil {
+			log.Error("failed to claim idempotency key", sl.Err(err))
+			return nil, status.Error(codes.Internal, "failed to process idempotency key")
+		}
+
+		if !started {
+			return replayResponse(ctx, log, stored, info.FullMethod, requestHash)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if relErr := store.ReleaseIdempotentRequest(ctx, userID, key); relErr != nil {
+				log.Error("failed to release idempotency key", sl.Err(relErr))
+			}
+			return nil, err
+		}
+
+		if err := saveResponse(ctx, store, userID, key, resp); err != nil {
+			// The call has succeeded; a retry will see the key as in progress
+			// until it expires rather than run twice.
+			log.Error("failed to store idempotent response", sl.Err(err))
+		}
+
+		if err := store.DeleteExpiredIdempotencyKeys(ctx); err != nil {
+			log.Warn("failed to clean up idempotency keys", sl.Err(err))
+		}
+
+		return resp, nil
+	}
+}
+
+func idempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(IdempotencyKeyHeader)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func hashRequest(req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func saveResponse(ctx context.Context, store IdempotencyStore, userID int64, key string, resp interface{}) error {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	packed, err := anypb.New(msg)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(packed)
+	if err != nil {
+		return err
+	}
+
+	return store.CompleteIdempotentRequest(ctx, userID, key, data)
+}
+
+func replayResponse(ctx context.Context, log *slog.Logger, stored models.IdempotencyKey, method, requestHash string) (interface{}, error) {
+	if stored.Method != method || stored.RequestHash != requestHash {
+		return nil, status.Error(codes.InvalidArgument, "idempotency key has already been used for another request")
+	}
+	if stored.Response == nil {
+		return nil, status.Error(codes.Aborted, "request with this idempotency key is still in progress")
+	}
+
+	var packed anypb.Any
+	if err := proto.Unmarshal(stored.Response, &packed); err != nil {
+		log.Error("failed to decode stored response", sl.Err(err))
+		return nil, status.Error(codes.Internal, "failed to replay response")
+	}
+	resp, err := packed.UnmarshalNew()
+	if err != nil {
+		log.Error("failed to decode stored response", sl.Err(err))
+		return nil, status.Error(codes.Internal, "failed to replay response")
+	}
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(idempotentReplayHeader, "true")); err != nil {
+		log.Warn("failed to set replay header", sl.Err(err))
+	}
+
+	log.Info("replayed idempotent response")
+
+	return resp, nil
+}
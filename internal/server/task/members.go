@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"mod1/internal/models"
+	auth "mod1/internal/server/auth"
+	taskv1 "mod1/proto/gen/go"
+)
+
+func (s *TaskServer) ShareTask(ctx context.Context, req *taskv1.ShareTaskRequest) (*taskv1.ShareTaskResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	member, err := s.Service.ShareTask(ctx, userID, req.TaskId, req.Email, models.MemberRole(req.Role))
+	if err != nil {
+		return nil, taskStatus(err, "failed to share task")
+	}
+
+	return &taskv1.ShareTaskResponse{Member: convertMemberToProto(member)}, nil
+}
+
+func (s *TaskServer) UnshareTask(ctx context.Context, req *taskv1.UnshareTaskRequest) (*taskv1.UnshareTaskResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.Service.UnshareTask(ctx, userID, req.TaskId, req.UserId); err != nil {
+		return nil, taskStatus(err, "failed to unshare task")
+	}
+
+	return &taskv1.UnshareTaskResponse{Success: true}, nil
+}
+
+func (s *TaskServer) ListTaskMembers(ctx context.Context, req *taskv1.ListTaskMembersRequest) (*taskv1.ListTaskMembersResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	members, err := s.Service.ListTaskMembers(ctx, userID, req.TaskId)
+	if err != nil {
+		return nil, taskStatus(err, "failed to list task members")
+	}
+
+	return &taskv1.ListTaskMembersResponse{Members: convertMembersToProto(members)}, nil
+}
+
+func (s *TaskServer) ShareProject(ctx context.Context, req *taskv1.ShareProjectRequest) (*taskv1.ShareProjectResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	member, err := s.Service.ShareProject(ctx, userID, req.ProjectId, req.Email, models.MemberRole(req.Role))
+	if err != nil {
+		return nil, taskStatus(err, "failed to share project")
+	}
+
+	return &taskv1.ShareProjectResponse{Member: convertMemberToProto(member)}, nil
+}
+
+func (s *TaskServer) UnshareProject(ctx context.Context, req *taskv1.UnshareProjectRequest) (*taskv1.UnshareProjectResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.Service.UnshareProject(ctx, userID, req.ProjectId, req.UserId); err != nil {
+		return nil, taskStatus(err, "failed to unshare project")
+	}
+
+	return &taskv1.UnshareProjectResponse{Success: true}, nil
+}
+
+func (s *TaskServer) ListProjectMembers(ctx context.Context, req *taskv1.ListProjectMembersRequest) (*taskv1.ListProjectMembersResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	members, err := s.Service.ListProjectMembers(ctx, userID, req.ProjectId)
+	if err != nil {
+		return nil, taskStatus(err, "failed to list project members")
+	}
+
+	return &taskv1.ListProjectMembersResponse{Members: convertMembersToProto(members)}, nil
+}
+
+func convertMembersToProto(members []models.Member) []*taskv1.Member {
+	protoMembers := make([]*taskv1.Member, 0, len(members))
+	for _, member := range members {
+		protoMembers = append(protoMembers, convertMemberToProto(member))
+	}
+	return protoMembers
+}
+
+func convertMemberToProto(member models.Member) *taskv1.Member {
+	var createdAt *timestamppb.Timestamp
+	if !member.CreatedAt.IsZero() {
+		createdAt = timestamppb.New(member.CreatedAt)
+	}
+
+	return &taskv1.Member{
+		UserId:    member.UserID,
+		Username:  member.Username,
+		Email:     member.Email,
+		Role:      taskv1.MemberRole(member.Role),
+		CreatedAt: createdAt,
+	}
+}
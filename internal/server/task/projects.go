@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"mod1/internal/models"
+	auth "mod1/internal/server/auth"
+	service "mod1/internal/services/task"
+	taskv1 "mod1/proto/gen/go"
+)
+
+func (s *TaskServer) CreateProject(ctx context.Context, req *taskv1.CreateProjectRequest) (*taskv1.CreateProjectResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	project, err := s.Service.CreateProject(ctx, userID, req.Name, req.Description, req.Color)
+	if err != nil {
+		return nil, projectStatus(err, "failed to create project")
+	}
+
+	return &taskv1.CreateProjectResponse{Project: convertProjectToProto(project)}, nil
+}
+
+func (s *TaskServer) GetProject(ctx context.Context, req *taskv1.GetProjectRequest) (*taskv1.GetProjectResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	project, err := s.Service.GetProject(ctx, userID, req.Id)
+	if err != nil {
+		return nil, projectStatus(err, "failed to get project")
+	}
+
+	return &taskv1.GetProjectResponse{Project: convertProjectToProto(project)}, nil
+}
+
+func (s *TaskServer) ListProjects(ctx context.Context, req *taskv1.ListProjectsRequest) (*taskv1.ListProjectsResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	projects, err := s.Service.ListProjects(ctx, userID, req.IncludeArchived)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list projects")
+	}
+
+	protoProjects := make([]*taskv1.Project, 0, len(projects))
+	for _, project := range projects {
+		protoProjects = append(protoProjects, convertProjectToProto(project))
+	}
+
+	return &taskv1.ListProjectsResponse{Projects: protoProjects}, nil
+}
+
+func (s *TaskServer) UpdateProject(ctx context.Context, req *taskv1.UpdateProjectRequest) (*taskv1.UpdateProjectResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	upd, err := projectUpdateFromRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	project, err := s.Service.UpdateProject(ctx, userID, req.Id, upd)
+	if err != nil {
+		return nil, projectStatus(err, "failed to update project")
+	}
+
+	return &taskv1.UpdateProjectResponse{Project: convertProjectToProto(project)}, nil
+}
+
+// projectUpdateFromRequest picks the fields listed in update_mask. An empty
+// mask or "*" replaces all fields.
+func projectUpdateFromRequest(req *taskv1.UpdateProjectRequest) (models.ProjectUpdate, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = []string{"*"}
+	}
+
+	var upd models.ProjectUpdate
+	for _, path := range paths {
+		switch path {
+		case "*":
+			upd.Name = &req.Name
+			upd.Description = &req.Description
+			upd.Color = &req.Color
+			upd.Archived = &req.Archived
+		case "name":
+			upd.Name = &req.Name
+		case "description":
+			upd.Description = &req.Description
+		case "color":
+			upd.Color = &req.Color
+		case "archived":
+			upd.Archived = &req.Archived
+		default:
+			return models.ProjectUpdate{}, fmt.Errorf("unknown update_mask path %q", path)
+		}
+	}
+
+	return upd, nil
+}
+
+func (s *TaskServer) DeleteProject(ctx context.Context, req *taskv1.DeleteProjectRequest) (*taskv1.DeleteProjectResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.Service.DeleteProject(ctx, userID, req.Id); err != nil {
+		return nil, projectStatus(err, "failed to delete project")
+	}
+
+	return &taskv1.DeleteProjectResponse{Success: true}, nil
+}
+
+func (s *TaskServer) MoveTask(ctx context.Context, req *taskv1.MoveTaskRequest) (*taskv1.MoveTaskResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.Service.MoveTask(ctx, userID, req.Id, req.ProjectId, req.ExpectedVersion); err != nil {
+		return nil, taskStatus(err, "failed to move task")
+	}
+
+	task, err := s.Service.GetTask(ctx, userID, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get moved task")
+	}
+
+	return &taskv1.MoveTaskResponse{Task: convertTaskToProto(task)}, nil
+}
+
+// projectStatus maps errors of project operations to gRPC statuses.
+func projectStatus(err error, internalMsg string) error {
+	switch {
+	case errors.Is(err, service.ErrProjectNotFound):
+		return status.Error(codes.NotFound, "project not found")
+	case errors.Is(err, service.ErrInvalidProjectName):
+		return status.Error(codes.InvalidArgument, service.ErrInvalidProjectName.Error())
+	case errors.Is(err, service.ErrInvalidColor):
+		return status.Error(codes.InvalidArgument, service.ErrInvalidColor.Error())
+	default:
+		return status.Error(codes.Internal, internalMsg)
+	}
+}
+
+func convertProjectToProto(project models.Project) *taskv1.Project {
+	var createdAt, updatedAt *timestamppb.Timestamp
+	if !project.CreatedAt.IsZero() {
+		createdAt = timestamppb.New(project.CreatedAt)
+	}
+	if !project.UpdatedAt.IsZero() {
+		updatedAt = timestamppb.New(project.UpdatedAt)
+	}
+
+	return &taskv1.Project{
+		Id:          project.ID,
+		Name:        project.Name,
+		Description: project.Description,
+		Color:       project.Color,
+		Archived:    project.Archived,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"mod1/internal/models"
+	taskv1 "mod1/proto/gen/go"
+	"reflect"
+	"testing"
+)
+
+func TestProjectUpdateFromRequest(t *testing.T) {
+	name := "Home"
+	description := "Chores"
+	color := "#aabbcc"
+	archived := true
+
+	req := func(mask ...string) *taskv1.UpdateProjectRequest {
+		r := &taskv1.UpdateProjectRequest{Id: 1, Name: name, Description: description, Color: color, Archived: archived}
+		if mask != nil {
+			r.UpdateMask = &fieldmaskpb.FieldMask{Paths: mask}
+		}
+		return r
+	}
+
+	tests := []struct {
+		name    string
+		req     *taskv1.UpdateProjectRequest
+		want    models.ProjectUpdate
+		wantErr bool
+	}{
+		{name: "no mask replaces all fields", req: req(), want: models.ProjectUpdate{Name: &name, Description: &description, Color: &color, Archived: &archived}},
+		{name: "wildcard", req: req("*"), want: models.ProjectUpdate{Name: &name, Description: &description, Color: &color, Archived: &archived}},
+		{name: "name only", req: req("name"), want: models.ProjectUpdate{Name: &name}},
+		{name: "color and archived", req: req("color", "archived"), want: models.ProjectUpdate{Color: &color, Archived: &archived}},
+		{name: "unknown path", req: req("owner_id"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := projectUpdateFromRequest(tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("projectUpdateFromRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("projectUpdateFromRequest() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
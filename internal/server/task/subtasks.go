@@ -0,0 +1,31 @@
+package server
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	auth "mod1/internal/server/auth"
+	taskv1 "mod1/proto/gen/go"
+)
+
+func (s *TaskServer) ListSubtasks(ctx context.Context, req *taskv1.ListSubtasksRequest) (*taskv1.ListSubtasksResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	tasks, err := s.Service.ListSubtasks(ctx, userID, req.ParentId, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, taskStatus(err, "failed to list subtasks")
+	}
+
+	protoTasks := make([]*taskv1.Task, 0, len(tasks))
+	for _, task := range tasks {
+		protoTasks = append(protoTasks, convertTaskToProto(task))
+	}
+
+	return &taskv1.ListSubtasksResponse{
+		Tasks:         protoTasks,
+		NextPageToken: req.PageToken + 1,
+	}, nil
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"mod1/internal/models"
+	auth "mod1/internal/server/auth"
+	service "mod1/internal/services/task"
+	taskv1 "mod1/proto/gen/go"
+)
+
+func (s *TaskServer) CreateTag(ctx context.Context, req *taskv1.CreateTagRequest) (*taskv1.CreateTagResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	tag, err := s.Service.CreateTag(ctx, userID, req.Name)
+	if err != nil {
+		return nil, tagStatus(err, "failed to create tag")
+	}
+
+	return &taskv1.CreateTagResponse{Tag: convertTagToProto(tag)}, nil
+}
+
+func (s *TaskServer) ListTags(ctx context.Context, req *taskv1.ListTagsRequest) (*taskv1.ListTagsResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	tags, err := s.Service.ListTags(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list tags")
+	}
+
+	protoTags := make([]*taskv1.Tag, 0, len(tags))
+	for _, tag := range tags {
+		protoTags = append(protoTags, convertTagToProto(tag))
+	}
+
+	return &taskv1.ListTagsResponse{Tags: protoTags}, nil
+}
+
+func (s *TaskServer) RenameTag(ctx context.Context, req *taskv1.RenameTagRequest) (*taskv1.RenameTagResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	tag, err := s.Service.RenameTag(ctx, userID, req.Id, req.Name)
+	if err != nil {
+		return nil, tagStatus(err, "failed to rename tag")
+	}
+
+	return &taskv1.RenameTagResponse{Tag: convertTagToProto(tag)}, nil
+}
+
+func (s *TaskServer) DeleteTag(ctx context.Context, req *taskv1.DeleteTagRequest) (*taskv1.DeleteTagResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.Service.DeleteTag(ctx, userID, req.Id); err != nil {
+		return nil, tagStatus(err, "failed to delete tag")
+	}
+
+	return &taskv1.DeleteTagResponse{Success: true}, nil
+}
+
+// tagStatus maps errors of tag operations to gRPC statuses.
+func tagStatus(err error, internalMsg string) error {
+	switch {
+	case errors.Is(err, service.ErrTagNotFound):
+		return status.Error(codes.NotFound, "tag not found")
+	case errors.Is(err, service.ErrTagExists):
+		return status.Error(codes.AlreadyExists, "tag with this name already exists")
+	case errors.Is(err, service.ErrInvalidTagName):
+		return status.Error(codes.InvalidArgument, service.ErrInvalidTagName.Error())
+	default:
+		return status.Error(codes.Internal, internalMsg)
+	}
+}
+
+func tagFilterFromProto(f *taskv1.TagFilter) models.TagFilter {
+	return models.TagFilter{
+		AnyOf:  f.GetAnyOf(),
+		AllOf:  f.GetAllOf(),
+		NoneOf: f.GetNoneOf(),
+	}
+}
+
+func convertTagToProto(tag models.Tag) *taskv1.Tag {
+	var createdAt *timestamppb.Timestamp
+	if !tag.CreatedAt.IsZero() {
+		createdAt = timestamppb.New(tag.CreatedAt)
+	}
+
+	return &taskv1.Tag{
+		Id:        tag.ID,
+		Name:      tag.Name,
+		CreatedAt: createdAt,
+	}
+}
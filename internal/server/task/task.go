@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -12,7 +13,7 @@ import (
 	service "mod1/internal/services/task"
 	"mod1/internal/storage"
 	taskv1 "mod1/proto/gen/go"
-	"time"
+	"strings"
 )
 
 type TaskServer struct {
@@ -20,7 +21,7 @@ type TaskServer struct {
 	Service *service.TaskService
 }
 
-var ErrTaskNotFound = errors.New("Task not found")
+var ErrTaskNotFound = service.ErrTaskNotFound
 
 func RegisterTaskServer(gRPCServer *grpc.Server, taskService *service.TaskService) {
 	taskv1.RegisterTaskServiceServer(gRPCServer, &TaskServer{Service: taskService})
@@ -32,14 +33,23 @@ func (s *TaskServer) CreateTask(ctx context.Context, req *taskv1.CreateTaskReque
 		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
 
-	var dueDate time.Time
+	newTask := models.NewTask{
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      models.TaskStatus(taskv1.TaskStatus_TASK_STATUS_OPEN),
+		Priority:    models.TaskPriority(req.Priority),
+		Tags:        req.Tags,
+		ProjectID:   req.ProjectId,
+		ParentID:    req.ParentId,
+	}
 	if req.DueDate != nil {
-		dueDate = req.DueDate.AsTime()
+		dueDate := req.DueDate.AsTime()
+		newTask.DueDate = &dueDate
 	}
 
-	taskID, err := s.Service.CreateTask(ctx, userID, req.Title, req.Description, dueDate, models.TaskStatus(taskv1.TaskStatus_TASK_STATUS_OPEN))
+	taskID, err := s.Service.CreateTask(ctx, userID, newTask)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to create task")
+		return nil, taskStatus(err, "failed to create task")
 	}
 
 	task, err := s.Service.GetTask(ctx, userID, taskID)
@@ -58,7 +68,12 @@ func (s *TaskServer) GetTask(ctx context.Context, req *taskv1.GetTaskRequest) (*
 		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
 
-	task, err := s.Service.GetTask(ctx, userID, req.Id)
+	var task *storage.Task
+	if req.Tree {
+		task, err = s.Service.GetTaskTree(ctx, userID, req.Id)
+	} else {
+		task, err = s.Service.GetTask(ctx, userID, req.Id)
+	}
 	if err != nil {
 		if errors.Is(err, ErrTaskNotFound) {
 			return nil, status.Error(codes.NotFound, "task not found")
@@ -77,17 +92,14 @@ func (s *TaskServer) UpdateTask(ctx context.Context, req *taskv1.UpdateTaskReque
 		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
 
-	var dueDate time.Time
-	if req.DueDate != nil {
-		dueDate = req.DueDate.AsTime()
+	upd, err := taskUpdateFromRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err = s.Service.UpdateTask(ctx, userID, req.Id, req.Title, req.Description, dueDate, models.TaskStatus(req.Status))
+	err = s.Service.UpdateTask(ctx, userID, req.Id, upd, req.ExpectedVersion)
 	if err != nil {
-		if errors.Is(err, ErrTaskNotFound) {
-			return nil, status.Error(codes.NotFound, "task not found")
-		}
-		return nil, status.Error(codes.Internal, "failed to update task")
+		return nil, taskStatus(err, "failed to update task")
 	}
 
 	task, err := s.Service.GetTask(ctx, userID, req.Id)
@@ -100,18 +112,131 @@ func (s *TaskServer) UpdateTask(ctx context.Context, req *taskv1.UpdateTaskReque
 	}, nil
 }
 
+// taskUpdateFromRequest picks the fields listed in update_mask. An empty
+// mask or "*" replaces all fields, as UpdateTask did before masks existed.
+func taskUpdateFromRequest(req *taskv1.UpdateTaskRequest) (models.TaskUpdate, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = []string{"*"}
+	}
+
+	var upd models.TaskUpdate
+	for _, path := range paths {
+		switch path {
+		case "*":
+			st := models.TaskStatus(req.Status)
+			upd.Title = &req.Title
+			upd.Description = &req.Description
+			upd.Status = &st
+			upd.UpdateDueDate = true
+			// Clients unaware of priorities, tags, projects and subtasks must not reset them.
+			if req.Priority != taskv1.TaskPriority_TASK_PRIORITY_UNSPECIFIED {
+				priority := models.TaskPriority(req.Priority)
+				upd.Priority = &priority
+			}
+			if len(req.Tags) > 0 {
+				upd.UpdateTags = true
+				upd.Tags = req.Tags
+			}
+			if req.ProjectId != 0 {
+				upd.UpdateProject = true
+				upd.ProjectID = req.ProjectId
+			}
+			if req.ParentId != 0 {
+				upd.UpdateParent = true
+				upd.ParentID = req.ParentId
+			}
+		case "title":
+			upd.Title = &req.Title
+		case "description":
+			upd.Description = &req.Description
+		case "status":
+			if req.Status == taskv1.TaskStatus_TASK_STATUS_UNSPECIFIED {
+				return models.TaskUpdate{}, errors.New("status is required when listed in update_mask")
+			}
+			st := models.TaskStatus(req.Status)
+			upd.Status = &st
+		case "priority":
+			if req.Priority == taskv1.TaskPriority_TASK_PRIORITY_UNSPECIFIED {
+				return models.TaskUpdate{}, errors.New("priority is required when listed in update_mask")
+			}
+			priority := models.TaskPriority(req.Priority)
+			upd.Priority = &priority
+		case "tags":
+			upd.UpdateTags = true
+			upd.Tags = req.Tags
+		case "project_id":
+			upd.UpdateProject = true
+			upd.ProjectID = req.ProjectId
+		case "parent_id":
+			upd.UpdateParent = true
+			upd.ParentID = req.ParentId
+		case "due_date":
+			upd.UpdateDueDate = true
+		default:
+			return models.TaskUpdate{}, fmt.Errorf("unknown update_mask path %q", path)
+		}
+	}
+
+	if upd.UpdateDueDate && req.DueDate != nil {
+		dueDate := req.DueDate.AsTime()
+		upd.DueDate = &dueDate
+	}
+
+	return upd, nil
+}
+
+// taskStatus maps errors of task changes to gRPC statuses.
+func taskStatus(err error, internalMsg string) error {
+	switch {
+	case errors.Is(err, ErrTaskNotFound):
+		return status.Error(codes.NotFound, "task not found")
+	case errors.Is(err, service.ErrVersionMismatch):
+		return status.Error(codes.Aborted, "task has been modified, reload it and retry")
+	case errors.Is(err, service.ErrInvalidTagName):
+		return status.Error(codes.InvalidArgument, service.ErrInvalidTagName.Error())
+	case errors.Is(err, service.ErrProjectNotFound):
+		return status.Error(codes.NotFound, "project not found")
+	case errors.Is(err, service.ErrProjectArchived):
+		return status.Error(codes.FailedPrecondition, "project is archived")
+	case errors.Is(err, service.ErrParentNotFound):
+		return status.Error(codes.NotFound, "parent task not found")
+	case errors.Is(err, service.ErrTaskCycle):
+		return status.Error(codes.InvalidArgument, service.ErrTaskCycle.Error())
+	case errors.Is(err, service.ErrTaskTooDeep):
+		return status.Error(codes.FailedPrecondition, service.ErrTaskTooDeep.Error())
+	case errors.Is(err, service.ErrDependencyCycle):
+		return status.Error(codes.InvalidArgument, service.ErrDependencyCycle.Error())
+	case errors.Is(err, service.ErrDependencyExists):
+		return status.Error(codes.AlreadyExists, "dependency already exists")
+	case errors.Is(err, service.ErrDependencyNotFound):
+		return status.Error(codes.NotFound, "dependency not found")
+	case errors.Is(err, service.ErrTaskBlocked):
+		return status.Error(codes.FailedPrecondition, "task is blocked by open tasks")
+	case errors.Is(err, service.ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, "your role does not allow this")
+	case errors.Is(err, service.ErrMemberNotFound):
+		return status.Error(codes.NotFound, "member not found")
+	case errors.Is(err, service.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, service.ErrMemberIsOwner):
+		return status.Error(codes.InvalidArgument, service.ErrMemberIsOwner.Error())
+	case errors.Is(err, service.ErrInvalidRole):
+		return status.Error(codes.InvalidArgument, service.ErrInvalidRole.Error())
+	default:
+		return status.Error(codes.Internal, internalMsg)
+	}
+}
+
 func (s *TaskServer) DeleteTask(ctx context.Context, req *taskv1.DeleteTaskRequest) (*taskv1.DeleteTaskResponse, error) {
 	userID, err := auth.GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
 
-	err = s.Service.DeleteTask(ctx, userID, req.Id)
+	err = s.Service.DeleteTask(ctx, userID, req.Id, req.ExpectedVersion)
 	if err != nil {
-		if errors.Is(err, ErrTaskNotFound) {
-			return nil, status.Error(codes.NotFound, "task not found")
-		}
-		return nil, status.Error(codes.Internal, "failed to delete task")
+		return nil, taskStatus(err, "failed to delete task")
 	}
 
 	return &taskv1.DeleteTaskResponse{Success: true}, nil
@@ -123,15 +248,34 @@ func (s *TaskServer) ListTasks(ctx context.Context, req *taskv1.ListTasksRequest
 		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
 
-	var dueDateFrom, dueDateTo time.Time
+	filter := models.TaskFilter{}
+	if req.Status != taskv1.TaskStatus_TASK_STATUS_UNSPECIFIED {
+		st := models.TaskStatus(req.Status)
+		filter.Status = &st
+	}
+	if req.Priority != taskv1.TaskPriority_TASK_PRIORITY_UNSPECIFIED {
+		priority := models.TaskPriority(req.Priority)
+		filter.Priority = &priority
+	}
+	if req.ProjectId != 0 {
+		filter.ProjectID = &req.ProjectId
+	}
+	filter.TopLevel = req.TopLevelOnly
 	if req.DueDateFrom != nil {
-		dueDateFrom = req.DueDateFrom.AsTime()
+		from := req.DueDateFrom.AsTime()
+		filter.DueDateFrom = &from
 	}
 	if req.DueDateTo != nil {
-		dueDateTo = req.DueDateTo.AsTime()
+		to := req.DueDateTo.AsTime()
+		filter.DueDateTo = &to
+	}
+	filter.Tags = tagFilterFromProto(req.TagFilter)
+	filter.OrderBy, err = parseOrderBy(req.OrderBy)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	tasks, err := s.Service.ListTasks(ctx, userID, (*models.TaskStatus)(&req.Status), &dueDateFrom, &dueDateTo, req.PageSize, req.PageToken)
+	tasks, err := s.Service.ListTasks(ctx, userID, filter, req.PageSize, req.PageToken)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to list tasks")
 	}
@@ -147,13 +291,50 @@ func (s *TaskServer) ListTasks(ctx context.Context, req *taskv1.ListTasksRequest
 	}, nil
 }
 
+// parseOrderBy parses order_by of ListTasks: comma-separated sort keys,
+// each optionally followed by "asc" or "desc".
+func parseOrderBy(orderBy string) ([]models.TaskOrder, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return nil, nil
+	}
+
+	var order []models.TaskOrder
+	for _, item := range strings.Split(orderBy, ",") {
+		parts := strings.Fields(item)
+		if len(parts) == 0 || len(parts) > 2 {
+			return nil, fmt.Errorf("invalid order_by item %q", strings.TrimSpace(item))
+		}
+
+		o := models.TaskOrder{Field: parts[0]}
+		switch o.Field {
+		case models.TaskOrderPriority, models.TaskOrderDueDate, models.TaskOrderCreatedAt, models.TaskOrderUpdatedAt:
+		default:
+			return nil, fmt.Errorf("unknown order_by field %q", o.Field)
+		}
+
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case "asc":
+			case "desc":
+				o.Desc = true
+			default:
+				return nil, fmt.Errorf("invalid order_by direction %q", parts[1])
+			}
+		}
+
+		order = append(order, o)
+	}
+
+	return order, nil
+}
+
 func (s *TaskServer) SearchTasks(ctx context.Context, req *taskv1.SearchTasksRequest) (*taskv1.SearchTasksResponse, error) {
 	userID, err := auth.GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "unauthorized")
 	}
 
-	tasks, err := s.Service.SearchTasks(ctx, userID, req.Query, req.PageSize, req.PageToken)
+	tasks, err := s.Service.SearchTasks(ctx, userID, req.Query, tagFilterFromProto(req.TagFilter), req.PageSize, req.PageToken)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to search tasks")
 	}
@@ -169,7 +350,36 @@ func (s *TaskServer) SearchTasks(ctx context.Context, req *taskv1.SearchTasksReq
 	}, nil
 }
 
+// ListUserTasks lets administrators read tasks of any user for support cases.
+func (s *TaskServer) ListUserTasks(ctx context.Context, req *taskv1.ListUserTasksRequest) (*taskv1.ListUserTasksResponse, error) {
+	var taskStatus *models.TaskStatus
+	if req.Status != taskv1.TaskStatus_TASK_STATUS_UNSPECIFIED {
+		st := models.TaskStatus(req.Status)
+		taskStatus = &st
+	}
+
+	tasks, err := s.Service.ListUserTasks(ctx, req.UserId, taskStatus, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list tasks")
+	}
+
+	protoTasks := make([]*taskv1.Task, 0, len(tasks))
+	for _, task := range tasks {
+		protoTasks = append(protoTasks, convertTaskToProto(task))
+	}
+
+	return &taskv1.ListUserTasksResponse{
+		Tasks:         protoTasks,
+		NextPageToken: req.PageToken + 1,
+	}, nil
+}
+
 func convertTaskToProto(task *storage.Task) *taskv1.Task {
+	var subtasks []*taskv1.Task
+	for _, subtask := range task.Subtasks {
+		subtasks = append(subtasks, convertTaskToProto(subtask))
+	}
+
 	var dueDate, createdAt, updatedAt *timestamppb.Timestamp
 	if task.DueDate != nil {
 		dueDate = timestamppb.New(*task.DueDate)
@@ -182,12 +392,23 @@ func convertTaskToProto(task *storage.Task) *taskv1.Task {
 	}
 
 	return &taskv1.Task{
-		Id:          task.ID,
-		Title:       task.Title,
-		Description: task.Description,
-		DueDate:     dueDate,
-		Status:      taskv1.TaskStatus(task.Status),
-		CreatedAt:   createdAt,
-		UpdatedAt:   updatedAt,
+		Id:                    task.ID,
+		Title:                 task.Title,
+		Description:           task.Description,
+		DueDate:               dueDate,
+		Status:                taskv1.TaskStatus(task.Status),
+		CreatedAt:             createdAt,
+		UpdatedAt:             updatedAt,
+		Version:               task.Version,
+		Priority:              taskv1.TaskPriority(task.Priority),
+		Tags:                  task.Tags,
+		ProjectId:             task.ProjectID,
+		ParentId:              task.ParentID,
+		SubtaskCount:          task.SubtaskCount,
+		CompletedSubtaskCount: task.CompletedSubtaskCount,
+		Subtasks:              subtasks,
+		BlockedBy:             task.BlockedBy,
+		Blocked:               task.Blocked,
+		OwnerId:               task.UserID,
 	}
 }
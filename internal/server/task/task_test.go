@@ -0,0 +1,160 @@
+package server
+
+import (
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"mod1/internal/models"
+	taskv1 "mod1/proto/gen/go"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseOrderBy(t *testing.T) {
+	tests := []struct {
+		name    string
+		orderBy string
+		want    []models.TaskOrder
+		wantErr bool
+	}{
+		{name: "empty", orderBy: ""},
+		{name: "blank", orderBy: "   "},
+		{name: "single field", orderBy: "priority", want: []models.TaskOrder{{Field: models.TaskOrderPriority}}},
+		{name: "explicit asc", orderBy: "due_date asc", want: []models.TaskOrder{{Field: models.TaskOrderDueDate}}},
+		{name: "desc any case", orderBy: "created_at DESC", want: []models.TaskOrder{{Field: models.TaskOrderCreatedAt, Desc: true}}},
+		{
+			name:    "several keys",
+			orderBy: "priority desc, due_date,updated_at asc",
+			want: []models.TaskOrder{
+				{Field: models.TaskOrderPriority, Desc: true},
+				{Field: models.TaskOrderDueDate},
+				{Field: models.TaskOrderUpdatedAt},
+			},
+		},
+		{name: "unknown field", orderBy: "title", wantErr: true},
+		{name: "field is case sensitive", orderBy: "Priority", wantErr: true},
+		{name: "unknown direction", orderBy: "priority up", wantErr: true},
+		{name: "too many words", orderBy: "priority desc nulls", wantErr: true},
+		{name: "empty item", orderBy: "priority,,due_date", wantErr: true},
+		{name: "trailing comma", orderBy: "priority,", wantErr: true},
+		{name: "sql injection", orderBy: "priority; DROP TABLE tasks", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOrderBy(tt.orderBy)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseOrderBy(%q) error = %v, wantErr %v", tt.orderBy, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseOrderBy(%q) = %+v, want %+v", tt.orderBy, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskUpdateFromRequest(t *testing.T) {
+	due := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	title := "Write report"
+	description := "Quarterly"
+	inProgress := models.TaskStatus(taskv1.TaskStatus_TASK_STATUS_IN_PROGRESS)
+	high := models.TaskPriority(taskv1.TaskPriority_TASK_PRIORITY_HIGH)
+	empty := ""
+
+	full := func(mask ...string) *taskv1.UpdateTaskRequest {
+		req := &taskv1.UpdateTaskRequest{
+			Id:          1,
+			Title:       title,
+			Description: description,
+			Status:      taskv1.TaskStatus_TASK_STATUS_IN_PROGRESS,
+			DueDate:     timestamppb.New(due),
+			Priority:    taskv1.TaskPriority_TASK_PRIORITY_HIGH,
+			Tags:        []string{"work"},
+			ProjectId:   7,
+			ParentId:    3,
+		}
+		if mask != nil {
+			req.UpdateMask = &fieldmaskpb.FieldMask{Paths: mask}
+		}
+		return req
+	}
+
+	tests := []struct {
+		name    string
+		req     *taskv1.UpdateTaskRequest
+		want    models.TaskUpdate
+		wantErr bool
+	}{
+		{
+			name: "no mask replaces all fields",
+			req:  full(),
+			want: models.TaskUpdate{
+				Title: &title, Description: &description, Status: &inProgress, Priority: &high,
+				UpdateDueDate: true, DueDate: &due,
+				UpdateTags: true, Tags: []string{"work"},
+				UpdateProject: true, ProjectID: 7,
+				UpdateParent: true, ParentID: 3,
+			},
+		},
+		{
+			name: "wildcard keeps unset priority, tags, project and parent",
+			req: &taskv1.UpdateTaskRequest{
+				Id:         1,
+				Title:      title,
+				Status:     taskv1.TaskStatus_TASK_STATUS_IN_PROGRESS,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"*"}},
+			},
+			want: models.TaskUpdate{Title: &title, Description: &empty, Status: &inProgress, UpdateDueDate: true},
+		},
+		{
+			name: "title only",
+			req:  full("title"),
+			want: models.TaskUpdate{Title: &title},
+		},
+		{
+			name: "clear due date",
+			req:  &taskv1.UpdateTaskRequest{Id: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"due_date"}}},
+			want: models.TaskUpdate{UpdateDueDate: true},
+		},
+		{
+			name: "clear tags, project and parent",
+			req:  &taskv1.UpdateTaskRequest{Id: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags", "project_id", "parent_id"}}},
+			want: models.TaskUpdate{UpdateTags: true, UpdateProject: true, UpdateParent: true},
+		},
+		{
+			name: "status and priority",
+			req:  full("status", "priority"),
+			want: models.TaskUpdate{Status: &inProgress, Priority: &high},
+		},
+		{
+			name:    "unspecified status in mask",
+			req:     &taskv1.UpdateTaskRequest{Id: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}}},
+			wantErr: true,
+		},
+		{
+			name:    "unspecified priority in mask",
+			req:     &taskv1.UpdateTaskRequest{Id: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"priority"}}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown path",
+			req:     full("owner_id"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := taskUpdateFromRequest(tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("taskUpdateFromRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("taskUpdateFromRequest() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mod1/config"
+	sl "mod1/internal/lib/logger"
+	"mod1/internal/models"
+	"mod1/internal/storage"
+	"time"
+)
+
+// ErrReauthRequired is returned when an account without a password confirms
+// a change neither with an MFA code nor with a recent login.
+var ErrReauthRequired = errors.New("recent re-authentication required")
+
+// Confirmation proves that the caller of a sensitive account change is the
+// account owner. Password is used when the account has one; accounts created
+// through SSO confirm with MfaCode or with TokenID, the jti of an access token
+// issued by a login not older than ReauthMaxAge.
+type Confirmation struct {
+	Password string
+	MfaCode  string
+	TokenID  string
+}
+
+func (a *Auth) GetProfile(ctx context.Context, userID int64) (models.User, error) {
+	const op = "Auth.GetProfile"
+
+	user, err := a.usrProvider.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return models.User{}, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		a.log.Error("failed to get user", slog.String("op", op), sl.Err(err))
+		return models.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+// UpdateProfile changes username and/or email; empty values are kept.
+// A new email has to be verified again, so a verification email is sent.
+func (a *Auth) UpdateProfile(ctx context.Context, userID int64, username, email string) (models.User, error) {
+	const op = "Auth.UpdateProfile"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.Int64("user_id", userID),
+	)
+
+	current, err := a.usrProvider.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return models.User{}, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to get user", sl.Err(err))
+		return models.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if username == "" {
+		username = current.Username
+	}
+	if email == "" {
+		email = current.Email
+	}
+
+	user, err := a.usrSaver.UpdateProfile(ctx, userID, username, email)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserExists) {
+			log.Info("username or email is taken")
+			return models.User{}, fmt.Errorf("%s: %w", op, ErrUserExists)
+		}
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return models.User{}, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to update profile", sl.Err(err))
+		return models.User{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user.Email != current.Email {
+		log.Info("email changed, verification required")
+		if err := a.sendVerification(ctx, user); err != nil {
+			// The user can ask for another email with ResendVerification.
+			log.Error("failed to send verification email", sl.Err(err))
+		}
+	}
+
+	log.Info("profile updated")
+
+	return user, nil
+}
+
+// ChangePassword replaces the password after the change is confirmed (see
+// Confirmation). SSO users set their first password this way.
+// All sessions are terminated; a new session is started for the client
+// that made the change, and its token pair is returned.
+func (a *Auth) ChangePassword(ctx context.Context, userID int64, confirm Confirmation, newPassword string, client ClientInfo) (TokenPair, error) {
+	const op = "Auth.ChangePassword"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.Int64("user_id", userID),
+	)
+
+	user, err := a.confirmUser(ctx, log, userID, confirm, client)
+	if err != nil {
+		log.Info("confirmation failed", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.checkPasswordPolicy(newPassword, user.Username, user.Email); err != nil {
+		log.Info("new password rejected", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	passHash, err := a.hasher.Hash(newPassword)
+	if err != nil {
+		log.Error("failed to generate password hash", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.resetTokens.UpdatePassword(ctx, user.ID, passHash); err != nil {
+		log.Error("failed to update password", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.LogoutAll(ctx, user.ID); err != nil {
+		log.Error("failed to terminate sessions", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Reload the user to get the token generation bumped by LogoutAll.
+	user, err = a.usrProvider.GetUserByID(ctx, user.ID)
+	if err != nil {
+		log.Error("failed to get user", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	sessionID, err := a.startSession(ctx, user.ID, client)
+	if err != nil {
+		log.Error("failed to start session", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pair, err := a.issueTokens(ctx, user, sessionID)
+	if err != nil {
+		log.Error("failed to generate tokens", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("password changed")
+
+	return pair, nil
+}
+
+// DeleteAccount removes the account after the deletion is confirmed. Tasks of
+// the user are deleted or kept anonymized depending on cfg.DeleteAccount.
+func (a *Auth) DeleteAccount(ctx context.Context, userID int64, confirm Confirmation, client ClientInfo) error {
+	const op = "Auth.DeleteAccount"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.Int64("user_id", userID),
+	)
+
+	if _, err := a.confirmUser(ctx, log, userID, confirm, client); err != nil {
+		log.Info("confirmation failed", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if a.cfg.DeleteAccount == config.DeleteAccountAnonymize {
+		if err := a.usrSaver.AnonymizeUser(ctx, userID); err != nil {
+			log.Error("failed to anonymize user", sl.Err(err))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		log.Info("account anonymized")
+		return nil
+	}
+
+	if err := a.usrSaver.DeleteUser(ctx, userID); err != nil {
+		log.Error("failed to delete user", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("account deleted")
+
+	return nil
+}
+
+// confirmUser loads the user and checks that the change is confirmed:
+// with the password, or for accounts without one with an MFA code or
+// a login within ReauthMaxAge. Passwords and MFA codes are checked under
+// the login throttle.
+func (a *Auth) confirmUser(ctx context.Context, log *slog.Logger, userID int64, confirm Confirmation, client ClientInfo) (models.User, error) {
+	user, err := a.usrProvider.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return models.User{}, ErrUserNotFound
+		}
+		return models.User{}, fmt.Errorf("get user: %w", err)
+	}
+
+	if len(user.PassHash) > 0 {
+		err := a.throttledCheck(ctx, log, user.Email, client.IP, func() error {
+			if err := a.hasher.Compare(user.PassHash, confirm.Password); err != nil {
+				return ErrInvalidCredentials
+			}
+			return nil
+		})
+		if err != nil {
+			return models.User{}, err
+		}
+		return user, nil
+	}
+
+	if confirm.MfaCode != "" && user.TotpEnabled {
+		err := a.throttledCheck(ctx, log, user.Email, client.IP, func() error {
+			return a.checkSecondFactor(ctx, user.ID, confirm.MfaCode)
+		})
+		if err != nil {
+			return models.User{}, err
+		}
+		return user, nil
+	}
+
+	if confirm.TokenID == "" {
+		return models.User{}, ErrReauthRequired
+	}
+	session, err := a.sessions.GetSessionByToken(ctx, user.ID, confirm.TokenID)
+	if err != nil {
+		if errors.Is(err, storage.ErrSessionNotFound) {
+			return models.User{}, ErrReauthRequired
+		}
+		return models.User{}, fmt.Errorf("get session: %w", err)
+	}
+	if time.Since(session.CreatedAt) > a.cfg.ReauthMaxAge {
+		return models.User{}, ErrReauthRequired
+	}
+
+	return user, nil
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	sl "mod1/internal/lib/logger"
+	"mod1/internal/models"
+	"mod1/internal/storage"
+)
+
+func (a *Auth) ListUsers(ctx context.Context, pageSize, pageToken int32) ([]models.User, error) {
+	const op = "Auth.ListUsers"
+
+	users, err := a.usrProvider.ListUsers(ctx, pageSize, pageToken)
+	if err != nil {
+		a.log.Error("failed to list users", slog.String("op", op), sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return users, nil
+}
+
+// DisableUser blocks the account: the user can no longer log in or refresh
+// tokens, and already issued tokens are invalidated.
+func (a *Auth) DisableUser(ctx context.Context, userID int64) error {
+	const op = "Auth.DisableUser"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.Int64("user_id", userID),
+	)
+
+	if err := a.usrSaver.SetUserDisabled(ctx, userID, true); err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", sl.Err(err))
+			return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to disable user", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.tokenStorage.RevokeUserRefreshTokens(ctx, userID); err != nil {
+		log.Error("failed to revoke refresh tokens", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("user disabled")
+
+	return nil
+}
+
+func (a *Auth) EnableUser(ctx context.Context, userID int64) error {
+	const op = "Auth.EnableUser"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.Int64("user_id", userID),
+	)
+
+	if err := a.usrSaver.SetUserDisabled(ctx, userID, false); err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", sl.Err(err))
+			return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to enable user", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("user enabled")
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mod1/config"
+	sl "mod1/internal/lib/logger"
+	"mod1/internal/lib/randtoken"
+	"mod1/internal/models"
+	"mod1/internal/storage"
+	"strings"
+	"time"
+)
+
+const (
+	apiKeyPrefix    = "tms_"
+	apiKeyPrefixLen = 8
+)
+
+var (
+	ErrApiKeyNotFound = errors.New("api key not found")
+	ErrInvalidApiKey  = errors.New("invalid api key")
+	ErrInvalidScope   = errors.New("invalid api key scope")
+)
+
+var knownScopes = map[string]bool{
+	models.ScopeTasksRead:  true,
+	models.ScopeTasksWrite: true,
+}
+
+type ApiKeyStorage interface {
+	CreateApiKey(ctx context.Context, userID int64, name, prefix, keyHash string, scopes []string, expiresAt *time.Time) (models.ApiKey, error)
+	GetApiKeyByHash(ctx context.Context, keyHash string) (models.ApiKey, error)
+	ListApiKeys(ctx context.Context, userID int64) ([]models.ApiKey, error)
+	RevokeApiKey(ctx context.Context, userID, keyID int64) error
+	TouchApiKey(ctx context.Context, keyID int64) error
+}
+
+// CreateApiKey mints a new key for the user. The plain key is returned
+// only here and cannot be recovered later.
+func (a *Auth) CreateApiKey(ctx context.Context, userID int64, name string, scopes []string, expiresAt *time.Time) (string, models.ApiKey, error) {
+	const op = "Auth.CreateApiKey"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.Int64("user_id", userID),
+	)
+
+	for _, scope := range scopes {
+		if !knownScopes[scope] {
+			return "", models.ApiKey{}, fmt.Errorf("%s: %w: %q", op, ErrInvalidScope, scope)
+		}
+	}
+
+	secret, err := randtoken.New()
+	if err != nil {
+		log.Error("failed to generate api key", sl.Err(err))
+		return "", models.ApiKey{}, fmt.Errorf("%s: %w", op, err)
+	}
+	key := apiKeyPrefix + secret
+
+	stored, err := a.apiKeys.CreateApiKey(ctx, userID, name, secret[:apiKeyPrefixLen], randtoken.Hash(key), scopes, expiresAt)
+	if err != nil {
+		log.Error("failed to save api key", sl.Err(err))
+		return "", models.ApiKey{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("api key created", slog.Int64("key_id", stored.ID))
+
+	return key, stored, nil
+}
+
+func (a *Auth) ListApiKeys(ctx context.Context, userID int64) ([]models.ApiKey, error) {
+	const op = "Auth.ListApiKeys"
+
+	keys, err := a.apiKeys.ListApiKeys(ctx, userID)
+	if err != nil {
+		a.log.Error("failed to list api keys", slog.String("op", op), sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return keys, nil
+}
+
+func (a *Auth) RevokeApiKey(ctx context.Context, userID, keyID int64) error {
+	const op = "Auth.RevokeApiKey"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.Int64("user_id", userID),
+		slog.Int64("key_id", keyID),
+	)
+
+	if err := a.apiKeys.RevokeApiKey(ctx, userID, keyID); err != nil {
+		if errors.Is(err, storage.ErrApiKeyNotFound) {
+			log.Warn("api key not found")
+			return fmt.Errorf("%s: %w", op, ErrApiKeyNotFound)
+		}
+
+		log.Error("failed to revoke api key", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("api key revoked")
+
+	return nil
+}
+
+// AuthenticateApiKey resolves the key to its owner. Revoked, expired keys
+// and keys of disabled users are rejected with ErrInvalidApiKey.
+func (a *Auth) AuthenticateApiKey(ctx context.Context, key string) (models.User, models.ApiKey, error) {
+	const op = "Auth.AuthenticateApiKey"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return models.User{}, models.ApiKey{}, fmt.Errorf("%s: %w", op, ErrInvalidApiKey)
+	}
+
+	stored, err := a.apiKeys.GetApiKeyByHash(ctx, randtoken.Hash(key))
+	if err != nil {
+		if errors.Is(err, storage.ErrApiKeyNotFound) {
+			return models.User{}, models.ApiKey{}, fmt.Errorf("%s: %w", op, ErrInvalidApiKey)
+		}
+
+		log.Error("failed to get api key", sl.Err(err))
+		return models.User{}, models.ApiKey{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if stored.RevokedAt != nil || (stored.ExpiresAt != nil && time.Now().After(*stored.ExpiresAt)) {
+		return models.User{}, models.ApiKey{}, fmt.Errorf("%s: %w", op, ErrInvalidApiKey)
+	}
+
+	user, err := a.usrProvider.GetUserByID(ctx, stored.UserID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return models.User{}, models.ApiKey{}, fmt.Errorf("%s: %w", op, ErrInvalidApiKey)
+		}
+
+		log.Error("failed to get user", sl.Err(err))
+		return models.User{}, models.ApiKey{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user.Disabled {
+		return models.User{}, models.ApiKey{}, fmt.Errorf("%s: %w", op, ErrInvalidApiKey)
+	}
+
+	if !user.EmailVerified {
+		switch a.cfg.UnverifiedAccess {
+		case config.UnverifiedAccessNone:
+			return models.User{}, models.ApiKey{}, fmt.Errorf("%s: %w", op, ErrInvalidApiKey)
+		case config.UnverifiedAccessRead:
+			stored.Scopes = intersect(stored.Scopes, a.restrictedScopes(user))
+		}
+	}
+
+	if err := a.apiKeys.TouchApiKey(ctx, stored.ID); err != nil {
+		log.Warn("failed to update api key usage", sl.Err(err))
+	}
+
+	return user, stored, nil
+}
+
+func intersect(a, b []string) []string {
+	var res []string
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				res = append(res, x)
+				break
+			}
+		}
+	}
+	return res
+}
@@ -5,84 +5,454 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"mod1/internal/lib/jwt"
+	"mod1/config"
 	sl "mod1/internal/lib/logger"
+	"mod1/internal/lib/randtoken"
 	"mod1/internal/models"
+	"mod1/internal/storage"
 	"time"
-
-	"golang.org/x/crypto/bcrypt"
 )
 
 var (
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrUserExists         = errors.New("user already exists")
 	ErrUserNotFound       = errors.New("user not found")
+	ErrInvalidToken       = errors.New("invalid refresh token")
+	ErrTokenRevoked       = errors.New("token has been revoked")
+	ErrUserDisabled       = errors.New("user is disabled")
+	ErrEmailNotVerified   = errors.New("email is not verified")
 )
 
 type Auth struct {
-	log         *slog.Logger
-	usrSaver    UserSaver
-	usrProvider UserProvider
-	tokenTTL    time.Duration
+	log          *slog.Logger
+	usrSaver     UserSaver
+	usrProvider  UserProvider
+	tokenStorage RefreshTokenStorage
+	revoker      TokenRevoker
+	apiKeys      ApiKeyStorage
+	resetTokens  PasswordResetStorage
+	verifier     EmailVerificationStorage
+	totp         TotpStorage
+	throttle     LoginThrottleStorage
+	sessions     SessionStorage
+	sso          SsoStorage
+	passwords    PasswordChecker
+	hasher       PasswordHasher
+	mailer       Mailer
+	issuer       TokenIssuer
+	idp          IdentityProvider // nil if SSO is disabled
+	cfg          config.AuthCfg
+}
+
+// TokenPair is the result of successful authentication.
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// LoginResult is returned by Login. For users with TOTP enabled Tokens is
+// empty and MfaToken has to be exchanged for tokens with VerifyMfa.
+type LoginResult struct {
+	Tokens   TokenPair
+	MfaToken string
 }
 
 type UserProvider interface {
 	GetUserByUsername(ctx context.Context, email string) (models.User, error)
+	GetUserByID(ctx context.Context, userID int64) (models.User, error)
+	ListUsers(ctx context.Context, pageSize, pageToken int32) ([]models.User, error)
 }
 
 type UserSaver interface {
 	Register(ctx context.Context, username, email string, passHash []byte) (int64, error)
+	SetUserDisabled(ctx context.Context, userID int64, disabled bool) error
+	UpdateProfile(ctx context.Context, userID int64, username, email string) (models.User, error)
+	DeleteUser(ctx context.Context, userID int64) error
+	AnonymizeUser(ctx context.Context, userID int64) error
+	RehashPassword(ctx context.Context, userID int64, oldHash, newHash []byte) error
+}
+
+type RefreshTokenStorage interface {
+	SaveRefreshToken(ctx context.Context, userID, sessionID int64, tokenHash string, expiresAt time.Time) error
+	GetRefreshToken(ctx context.Context, tokenHash string) (models.RefreshToken, error)
+	RevokeRefreshToken(ctx context.Context, tokenID int64) error
+	RevokeUserRefreshTokens(ctx context.Context, userID int64) error
+}
+
+type TokenRevoker interface {
+	RevokeToken(ctx context.Context, jti string, userID int64, expiresAt time.Time) error
+	DeleteExpiredRevokedTokens(ctx context.Context) error
+	GetTokenState(ctx context.Context, userID int64, jti string) (int64, bool, error)
+	IncrementTokenGeneration(ctx context.Context, userID int64) error
+}
+
+// Mailer delivers emails to users.
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+type TokenIssuer interface {
+	NewToken(user models.User, duration time.Duration, scopes ...string) (string, string, error)
 }
 
 func New(
 	log *slog.Logger,
 	userSaver UserSaver,
 	userProvider UserProvider,
-	tokenTTL time.Duration,
+	tokenStorage RefreshTokenStorage,
+	revoker TokenRevoker,
+	apiKeys ApiKeyStorage,
+	resetTokens PasswordResetStorage,
+	verifier EmailVerificationStorage,
+	totp TotpStorage,
+	throttle LoginThrottleStorage,
+	sessions SessionStorage,
+	sso SsoStorage,
+	passwords PasswordChecker,
+	hasher PasswordHasher,
+	mailer Mailer,
+	issuer TokenIssuer,
+	idp IdentityProvider,
+	cfg config.AuthCfg,
 ) *Auth {
 	return &Auth{
-		usrSaver:    userSaver,
-		usrProvider: userProvider,
-		log:         log,
-		tokenTTL:    tokenTTL,
+		usrSaver:     userSaver,
+		usrProvider:  userProvider,
+		tokenStorage: tokenStorage,
+		revoker:      revoker,
+		apiKeys:      apiKeys,
+		resetTokens:  resetTokens,
+		verifier:     verifier,
+		totp:         totp,
+		throttle:     throttle,
+		sessions:     sessions,
+		sso:          sso,
+		passwords:    passwords,
+		hasher:       hasher,
+		idp:          idp,
+		mailer:       mailer,
+		issuer:       issuer,
+		log:          log,
+		cfg:          cfg,
 	}
 }
 
-func (a *Auth) Login(ctx context.Context, email string, password string) (string, error) {
+// Login checks credentials of the user. client.IP is used for brute-force
+// protection and, with the user agent, recorded in the new session.
+func (a *Auth) Login(ctx context.Context, email string, password string, client ClientInfo) (LoginResult, error) {
 	const op = "Auth.Login"
 
 	log := a.log.With(
 		slog.String("op", op),
 		slog.String("email", email),
+		slog.String("ip", client.IP),
 	)
 
 	log.Info("attempting to login user")
 
+	if err := a.checkLoginLock(ctx, email, client.IP); err != nil {
+		var lockErr *LockoutError
+		if errors.As(err, &lockErr) {
+			log.Warn("login attempt while locked", slog.Time("locked_until", lockErr.Until))
+		} else {
+			log.Error("failed to check login lock", sl.Err(err))
+		}
+		return LoginResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
 	user, err := a.usrProvider.GetUserByUsername(ctx, email)
 	if err != nil {
-		if errors.Is(err, ErrUserNotFound) {
+		if errors.Is(err, storage.ErrUserNotFound) {
 			log.Warn("user not found", sl.Err(err))
-			return "", fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+			a.recordLoginFailure(ctx, log, email, client.IP)
+			return LoginResult{}, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 		}
 
 		log.Error("failed to get user", sl.Err(err))
-		return "", fmt.Errorf("%s: %w", op, err)
+		return LoginResult{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	if err := bcrypt.CompareHashAndPassword(user.PassHash, []byte(password)); err != nil {
+	if err := a.hasher.Compare(user.PassHash, password); err != nil {
 		log.Info("invalid credentials", sl.Err(err))
-		return "", fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		a.recordLoginFailure(ctx, log, email, client.IP)
+		return LoginResult{}, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	// With MFA the account throttle is reset only after the second factor,
+	// otherwise a known password would allow unlimited code guessing.
+	if !user.TotpEnabled {
+		a.resetLoginFailures(ctx, log, email)
+	}
+	a.upgradePasswordHash(ctx, log, user, password)
+
+	result, err := a.finishLogin(ctx, log, user, client)
+	if err != nil {
+		return LoginResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// finishLogin performs checks shared by all login methods once the user is
+// authenticated, and either issues tokens or starts an MFA challenge.
+func (a *Auth) finishLogin(ctx context.Context, log *slog.Logger, user models.User, client ClientInfo) (LoginResult, error) {
+	if user.Disabled {
+		log.Warn("login attempt for disabled user", slog.Int64("user_id", user.ID))
+		return LoginResult{}, ErrUserDisabled
+	}
+
+	if !user.EmailVerified && a.cfg.UnverifiedAccess == config.UnverifiedAccessNone {
+		log.Info("login attempt with unverified email", slog.Int64("user_id", user.ID))
+		return LoginResult{}, ErrEmailNotVerified
+	}
+
+	if user.TotpEnabled {
+		mfaToken, err := a.startMfaChallenge(ctx, user)
+		if err != nil {
+			log.Error("failed to start mfa challenge", sl.Err(err))
+			return LoginResult{}, err
+		}
+
+		log.Info("first factor accepted, waiting for second factor", slog.Int64("user_id", user.ID))
+
+		return LoginResult{MfaToken: mfaToken}, nil
+	}
+
+	log.Info("user logged in successfully", slog.Int64("user_id", user.ID))
+
+	sessionID, err := a.startSession(ctx, user.ID, client)
+	if err != nil {
+		log.Error("failed to start session", sl.Err(err))
+		return LoginResult{}, err
+	}
+
+	pair, err := a.issueTokens(ctx, user, sessionID)
+	if err != nil {
+		log.Error("failed to generate tokens", sl.Err(err))
+		return LoginResult{}, err
+	}
+
+	return LoginResult{Tokens: pair}, nil
+}
+
+// RefreshToken exchanges a valid refresh token for a new token pair.
+// The presented refresh token is rotated: it can be used only once.
+// Presenting an already used token is treated as theft, and all
+// refresh tokens of the user are revoked. client is only used to start
+// a session for tokens issued before sessions were tracked.
+func (a *Auth) RefreshToken(ctx context.Context, refreshToken string, client ClientInfo) (TokenPair, error) {
+	const op = "Auth.RefreshToken"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	stored, err := a.tokenStorage.GetRefreshToken(ctx, randtoken.Hash(refreshToken))
+	if err != nil {
+		if errors.Is(err, storage.ErrRefreshTokenNotFound) {
+			log.Warn("refresh token not found")
+			return TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidToken)
+		}
+
+		log.Error("failed to get refresh token", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log = log.With(slog.Int64("user_id", stored.UserID))
+
+	if stored.RevokedAt != nil {
+		log.Warn("refresh token reuse detected, revoking all user tokens")
+		if err := a.tokenStorage.RevokeUserRefreshTokens(ctx, stored.UserID); err != nil {
+			log.Error("failed to revoke user refresh tokens", sl.Err(err))
+		}
+		if err := a.sessions.RevokeUserSessions(ctx, stored.UserID); err != nil {
+			log.Error("failed to revoke user sessions", sl.Err(err))
+		}
+		return TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidToken)
+	}
+
+	if time.Now().After(stored.ExpiresAt) {
+		log.Info("refresh token expired")
+		return TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidToken)
+	}
+
+	if err := a.tokenStorage.RevokeRefreshToken(ctx, stored.ID); err != nil {
+		if errors.Is(err, storage.ErrRefreshTokenNotFound) {
+			log.Warn("refresh token was used concurrently")
+			return TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidToken)
+		}
+
+		log.Error("failed to revoke refresh token", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	user, err := a.usrProvider.GetUserByID(ctx, stored.UserID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", sl.Err(err))
+			return TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidToken)
+		}
+
+		log.Error("failed to get user", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user.Disabled {
+		log.Warn("refresh attempt for disabled user")
+		return TokenPair{}, fmt.Errorf("%s: %w", op, ErrUserDisabled)
+	}
+
+	if !user.EmailVerified && a.cfg.UnverifiedAccess == config.UnverifiedAccessNone {
+		log.Info("refresh attempt with unverified email")
+		return TokenPair{}, fmt.Errorf("%s: %w", op, ErrEmailNotVerified)
+	}
+
+	sessionID := stored.SessionID
+	if sessionID == 0 {
+		sessionID, err = a.startSession(ctx, user.ID, client)
+		if err != nil {
+			log.Error("failed to start session", sl.Err(err))
+			return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	pair, err := a.issueTokens(ctx, user, sessionID)
+	if err != nil {
+		log.Error("failed to generate tokens", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("tokens refreshed")
+
+	return pair, nil
+}
+
+// Logout revokes the access token identified by jti and, if given,
+// the refresh token issued together with it.
+func (a *Auth) Logout(ctx context.Context, userID int64, jti string, expiresAt time.Time, refreshToken string) error {
+	const op = "Auth.Logout"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.Int64("user_id", userID),
+	)
+
+	if err := a.revoker.RevokeToken(ctx, jti, userID, expiresAt); err != nil {
+		log.Error("failed to revoke access token", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.sessions.RevokeSessionByToken(ctx, userID, jti); err != nil {
+		log.Error("failed to end session", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if refreshToken != "" {
+		stored, err := a.tokenStorage.GetRefreshToken(ctx, randtoken.Hash(refreshToken))
+		switch {
+		case errors.Is(err, storage.ErrRefreshTokenNotFound):
+			log.Warn("refresh token not found")
+		case err != nil:
+			log.Error("failed to get refresh token", sl.Err(err))
+			return fmt.Errorf("%s: %w", op, err)
+		case stored.UserID != userID:
+			log.Warn("refresh token belongs to another user")
+		case stored.RevokedAt == nil:
+			if err := a.tokenStorage.RevokeRefreshToken(ctx, stored.ID); err != nil && !errors.Is(err, storage.ErrRefreshTokenNotFound) {
+				log.Error("failed to revoke refresh token", sl.Err(err))
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+
+	if err := a.revoker.DeleteExpiredRevokedTokens(ctx); err != nil {
+		log.Warn("failed to clean up revoked tokens", sl.Err(err))
+	}
+
+	log.Info("user logged out")
+
+	return nil
+}
+
+// LogoutAll invalidates every access and refresh token issued to the user.
+func (a *Auth) LogoutAll(ctx context.Context, userID int64) error {
+	const op = "Auth.LogoutAll"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.Int64("user_id", userID),
+	)
+
+	if err := a.revoker.IncrementTokenGeneration(ctx, userID); err != nil {
+		log.Error("failed to increment token generation", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.tokenStorage.RevokeUserRefreshTokens(ctx, userID); err != nil {
+		log.Error("failed to revoke refresh tokens", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.sessions.RevokeUserSessions(ctx, userID); err != nil {
+		log.Error("failed to revoke sessions", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("user logged out everywhere")
+
+	return nil
+}
+
+// ValidateToken checks that the token was neither revoked individually
+// nor issued before the last LogoutAll of its user.
+func (a *Auth) ValidateToken(ctx context.Context, userID int64, jti string, generation int64) error {
+	const op = "Auth.ValidateToken"
+
+	current, revoked, err := a.revoker.GetTokenState(ctx, userID, jti)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrTokenRevoked)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if revoked || generation != current {
+		return fmt.Errorf("%s: %w", op, ErrTokenRevoked)
+	}
+
+	if err := a.sessions.TouchSession(ctx, userID, jti); err != nil {
+		a.log.Warn("failed to update session last seen time", slog.String("op", op), sl.Err(err))
 	}
 
-	log.Info("user logged in successfully")
+	return nil
+}
+
+// issueTokens creates access token and persists new refresh token for
+// the given session of the user.
+func (a *Auth) issueTokens(ctx context.Context, user models.User, sessionID int64) (TokenPair, error) {
+	accessToken, jti, err := a.issuer.NewToken(user, a.cfg.TokenTTL, a.restrictedScopes(user)...)
+	if err != nil {
+		return TokenPair{}, fmt.Errorf("generate access token: %w", err)
+	}
+
+	if err := a.sessions.UpdateSessionToken(ctx, sessionID, jti, time.Now().Add(a.cfg.TokenTTL)); err != nil {
+		return TokenPair{}, fmt.Errorf("update session: %w", err)
+	}
 
-	token, err := jwt.NewToken(user, a.tokenTTL)
+	refreshToken, err := randtoken.New()
 	if err != nil {
-		log.Error("failed to generate token", sl.Err(err))
-		return "", fmt.Errorf("%s: %w", op, err)
+		return TokenPair{}, fmt.Errorf("generate refresh token: %w", err)
 	}
 
-	return token, nil
+	expiresAt := time.Now().Add(a.cfg.RefreshTokenTTL)
+	if err := a.tokenStorage.SaveRefreshToken(ctx, user.ID, sessionID, randtoken.Hash(refreshToken), expiresAt); err != nil {
+		return TokenPair{}, fmt.Errorf("save refresh token: %w", err)
+	}
+
+	return TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
 }
 
 func (a *Auth) RegisterNewUser(
@@ -101,7 +471,12 @@ func (a *Auth) RegisterNewUser(
 
 	log.Info("registering new user")
 
-	passHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err := a.checkPasswordPolicy(password, username, email); err != nil {
+		log.Info("password rejected", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	passHash, err := a.hasher.Hash(password)
 	if err != nil {
 		log.Error("failed to generate password hash", sl.Err(err))
 		return 0, fmt.Errorf("%s: %w", op, err)
@@ -109,7 +484,7 @@ func (a *Auth) RegisterNewUser(
 
 	id, err := a.usrSaver.Register(ctx, username, email, passHash)
 	if err != nil {
-		if errors.Is(err, ErrUserExists) {
+		if errors.Is(err, storage.ErrUserExists) {
 			log.Warn("user already exists", sl.Err(err))
 			return 0, fmt.Errorf("%s: %w", op, ErrUserExists)
 		}
@@ -119,5 +494,12 @@ func (a *Auth) RegisterNewUser(
 	}
 
 	log.Info("user registered successfully", slog.Int64("user_id", id))
+
+	user := models.User{ID: id, Username: username, Email: email}
+	if err := a.sendVerification(ctx, user); err != nil {
+		// The user can ask for another email with ResendVerification.
+		log.Error("failed to send verification email", sl.Err(err))
+	}
+
 	return id, nil
 }
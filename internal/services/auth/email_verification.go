@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mod1/config"
+	sl "mod1/internal/lib/logger"
+	"mod1/internal/lib/randtoken"
+	"mod1/internal/models"
+	"mod1/internal/storage"
+	"net/url"
+	"time"
+)
+
+var ErrInvalidVerificationToken = errors.New("invalid email verification token")
+
+type EmailVerificationStorage interface {
+	SaveEmailVerificationToken(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error
+	VerifyEmail(ctx context.Context, tokenHash string) (int64, error)
+}
+
+// VerifyEmail confirms email ownership with a token sent by sendVerification.
+// Tokens issued before verification stay restricted until they are refreshed.
+func (a *Auth) VerifyEmail(ctx context.Context, token string) error {
+	const op = "Auth.VerifyEmail"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	userID, err := a.verifier.VerifyEmail(ctx, randtoken.Hash(token))
+	if err != nil {
+		if errors.Is(err, storage.ErrVerificationNotFound) {
+			log.Warn("invalid verification token")
+			return fmt.Errorf("%s: %w", op, ErrInvalidVerificationToken)
+		}
+
+		log.Error("failed to verify email", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("email verified", slog.Int64("user_id", userID))
+
+	return nil
+}
+
+// ResendVerification sends a new verification email. Like RequestPasswordReset
+// it does not reveal whether the address is registered.
+func (a *Auth) ResendVerification(ctx context.Context, email string) error {
+	const op = "Auth.ResendVerification"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("email", email),
+	)
+
+	user, err := a.usrProvider.GetUserByUsername(ctx, email)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Info("verification requested for unknown email")
+			return nil
+		}
+
+		log.Error("failed to get user", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user.EmailVerified || user.Disabled {
+		log.Info("verification is not needed", slog.Int64("user_id", user.ID))
+		return nil
+	}
+
+	if err := a.sendVerification(ctx, user); err != nil {
+		log.Error("failed to send verification email", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (a *Auth) sendVerification(ctx context.Context, user models.User) error {
+	token, err := randtoken.New()
+	if err != nil {
+		return fmt.Errorf("generate verification token: %w", err)
+	}
+
+	expiresAt := time.Now().Add(a.cfg.VerifyEmailTTL)
+	if err := a.verifier.SaveEmailVerificationToken(ctx, user.ID, randtoken.Hash(token), expiresAt); err != nil {
+		return fmt.Errorf("save verification token: %w", err)
+	}
+
+	link := a.cfg.VerifyEmailURL + "?token=" + url.QueryEscape(token)
+	body := fmt.Sprintf("Hello, %s!\n\n"+
+		"Please confirm your email address by following the link below:\n%s\n\n"+
+		"Verification code: %s\n\n"+
+		"The link is valid for %s.\n",
+		user.Username, link, token, a.cfg.VerifyEmailTTL)
+
+	if err := a.mailer.Send(ctx, user.Email, "Confirm your email", body); err != nil {
+		return fmt.Errorf("send email: %w", err)
+	}
+
+	return nil
+}
+
+// restrictedScopes returns scopes access tokens of the user are limited to,
+// or nil if the user is not restricted.
+func (a *Auth) restrictedScopes(user models.User) []string {
+	if user.EmailVerified || a.cfg.UnverifiedAccess != config.UnverifiedAccessRead {
+		return nil
+	}
+
+	return []string{models.ScopeTasksRead, models.ScopeAccount}
+}
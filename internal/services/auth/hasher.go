@@ -0,0 +1,225 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"mod1/config"
+	sl "mod1/internal/lib/logger"
+	"mod1/internal/models"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	argon2SaltLen = 16
+	argon2KeyLen  = 32
+)
+
+var (
+	errPasswordMismatch = errors.New("password does not match")
+	errUnknownHash      = errors.New("unknown password hash format")
+)
+
+// PasswordHasher hashes passwords for storage and verifies them. The
+// algorithm and its parameters are recorded in the hash itself, so hashes
+// made with different settings can be verified side by side.
+type PasswordHasher interface {
+	Hash(password string) ([]byte, error)
+	Compare(hash []byte, password string) error
+	// NeedsRehash reports whether hash uses another algorithm or weaker
+	// parameters than Hash would use now.
+	NeedsRehash(hash []byte) bool
+}
+
+type argon2Params struct {
+	time    uint32
+	memory  uint32 // KiB
+	threads uint8
+}
+
+type passwordHasher struct {
+	algorithm  string
+	bcryptCost int
+	argon2     argon2Params
+}
+
+// NewPasswordHasher creates a hasher producing hashes with cfg.Algorithm:
+// argon2id in PHC string format or bcrypt in modular crypt format. Parameters
+// are checked here so that a bad config fails at startup rather than on
+// the first login or registration.
+func NewPasswordHasher(cfg config.PasswordHashCfg) (PasswordHasher, error) {
+	algorithm := cfg.Algorithm
+	if algorithm == "" {
+		algorithm = config.PasswordHashBcrypt
+	}
+
+	switch algorithm {
+	case config.PasswordHashBcrypt:
+		if cfg.BcryptCost < bcrypt.MinCost || cfg.BcryptCost > bcrypt.MaxCost {
+			return nil, fmt.Errorf("bcryptCost must be between %d and %d, got %d",
+				bcrypt.MinCost, bcrypt.MaxCost, cfg.BcryptCost)
+		}
+	case config.PasswordHashArgon2id:
+		if cfg.Argon2Time < 1 {
+			return nil, fmt.Errorf("argon2Time must be at least 1, got %d", cfg.Argon2Time)
+		}
+		if cfg.Argon2Threads < 1 || cfg.Argon2Threads > math.MaxUint8 {
+			return nil, fmt.Errorf("argon2Threads must be between 1 and %d, got %d", math.MaxUint8, cfg.Argon2Threads)
+		}
+		// argon2 requires at least 8 KiB per lane.
+		if cfg.Argon2Memory < 8*cfg.Argon2Threads {
+			return nil, fmt.Errorf("argon2Memory must be at least %d KiB for %d threads, got %d",
+				8*cfg.Argon2Threads, cfg.Argon2Threads, cfg.Argon2Memory)
+		}
+	default:
+		return nil, fmt.Errorf("unknown password hash algorithm %q", cfg.Algorithm)
+	}
+
+	return &passwordHasher{
+		algorithm:  algorithm,
+		bcryptCost: cfg.BcryptCost,
+		argon2: argon2Params{
+			time:    uint32(cfg.Argon2Time),
+			memory:  uint32(cfg.Argon2Memory),
+			threads: uint8(cfg.Argon2Threads),
+		},
+	}, nil
+}
+
+func (h *passwordHasher) Hash(password string) ([]byte, error) {
+	if h.algorithm == config.PasswordHashArgon2id {
+		salt := make([]byte, argon2SaltLen)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, fmt.Errorf("generate salt: %w", err)
+		}
+
+		key := argon2.IDKey([]byte(password), salt, h.argon2.time, h.argon2.memory, h.argon2.threads, argon2KeyLen)
+
+		return encodeArgon2(h.argon2, salt, key), nil
+	}
+
+	return bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
+}
+
+func (h *passwordHasher) Compare(hash []byte, password string) error {
+	if isArgon2Hash(hash) {
+		params, salt, key, err := decodeArgon2(hash)
+		if err != nil {
+			return err
+		}
+
+		other := argon2.IDKey([]byte(password), salt, params.time, params.memory, params.threads, uint32(len(key)))
+		if subtle.ConstantTimeCompare(key, other) != 1 {
+			return errPasswordMismatch
+		}
+
+		return nil
+	}
+
+	if err := bcrypt.CompareHashAndPassword(hash, []byte(password)); err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return errPasswordMismatch
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (h *passwordHasher) NeedsRehash(hash []byte) bool {
+	if h.algorithm == config.PasswordHashArgon2id {
+		if !isArgon2Hash(hash) {
+			return true
+		}
+
+		params, _, key, err := decodeArgon2(hash)
+		if err != nil {
+			return true
+		}
+
+		return params.time < h.argon2.time ||
+			params.memory < h.argon2.memory ||
+			params.threads < h.argon2.threads ||
+			len(key) < argon2KeyLen
+	}
+
+	cost, err := bcrypt.Cost(hash)
+	if err != nil {
+		// Not a bcrypt hash.
+		return true
+	}
+
+	return cost < h.bcryptCost
+}
+
+func isArgon2Hash(hash []byte) bool {
+	return bytes.HasPrefix(hash, []byte("$argon2id$"))
+}
+
+// encodeArgon2 formats the hash as $argon2id$v=19$m=<KiB>,t=<n>,p=<n>$<salt>$<key>.
+func encodeArgon2(params argon2Params, salt, key []byte) []byte {
+	return []byte(fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, params.memory, params.time, params.threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key)))
+}
+
+func decodeArgon2(hash []byte) (argon2Params, []byte, []byte, error) {
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, key
+	parts := strings.Split(string(hash), "$")
+	if len(parts) != 6 {
+		return argon2Params{}, nil, nil, errUnknownHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return argon2Params{}, nil, nil, errUnknownHash
+	}
+
+	var params argon2Params
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads); err != nil {
+		return argon2Params{}, nil, nil, errUnknownHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return argon2Params{}, nil, nil, errUnknownHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return argon2Params{}, nil, nil, errUnknownHash
+	}
+
+	return params, salt, key, nil
+}
+
+// upgradePasswordHash re-hashes the password just verified by Login if the
+// stored hash is outdated. Failures are only logged: the login itself has
+// already succeeded and the upgrade is retried next time.
+func (a *Auth) upgradePasswordHash(ctx context.Context, log *slog.Logger, user models.User, password string) {
+	if !a.hasher.NeedsRehash(user.PassHash) {
+		return
+	}
+
+	passHash, err := a.hasher.Hash(password)
+	if err != nil {
+		log.Error("failed to re-hash password", sl.Err(err))
+		return
+	}
+
+	if err := a.usrSaver.RehashPassword(ctx, user.ID, user.PassHash, passHash); err != nil {
+		log.Error("failed to save re-hashed password", sl.Err(err))
+		return
+	}
+
+	log.Info("password hash upgraded", slog.Int64("user_id", user.ID))
+}
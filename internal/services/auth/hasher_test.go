@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"errors"
+	"mod1/config"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Cheap parameters keep the tests fast; they are still valid for NewPasswordHasher.
+var (
+	testBcrypt = config.PasswordHashCfg{Algorithm: config.PasswordHashBcrypt, BcryptCost: bcrypt.MinCost}
+	testArgon2 = config.PasswordHashCfg{Algorithm: config.PasswordHashArgon2id, Argon2Time: 1, Argon2Memory: 64, Argon2Threads: 1}
+)
+
+func mustHasher(t *testing.T, cfg config.PasswordHashCfg) PasswordHasher {
+	t.Helper()
+
+	h, err := NewPasswordHasher(cfg)
+	if err != nil {
+		t.Fatalf("NewPasswordHasher(%+v): %v", cfg, err)
+	}
+	return h
+}
+
+func mustHash(t *testing.T, h PasswordHasher, password string) []byte {
+	t.Helper()
+
+	hash, err := h.Hash(password)
+	if err != nil {
+		t.Fatalf("Hash: %v", err)
+	}
+	return hash
+}
+
+func TestNewPasswordHasherValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.PasswordHashCfg
+		wantErr bool
+	}{
+		{name: "bcrypt", cfg: testBcrypt},
+		{name: "default algorithm", cfg: config.PasswordHashCfg{BcryptCost: 10}},
+		{name: "argon2id", cfg: testArgon2},
+		{name: "unknown algorithm", cfg: config.PasswordHashCfg{Algorithm: "md5", BcryptCost: 10}, wantErr: true},
+		{name: "bcrypt cost too low", cfg: config.PasswordHashCfg{Algorithm: config.PasswordHashBcrypt, BcryptCost: bcrypt.MinCost - 1}, wantErr: true},
+		{name: "bcrypt cost too high", cfg: config.PasswordHashCfg{Algorithm: config.PasswordHashBcrypt, BcryptCost: bcrypt.MaxCost + 1}, wantErr: true},
+		{name: "argon2 zero time", cfg: config.PasswordHashCfg{Algorithm: config.PasswordHashArgon2id, Argon2Time: 0, Argon2Memory: 64, Argon2Threads: 1}, wantErr: true},
+		{name: "argon2 zero threads", cfg: config.PasswordHashCfg{Algorithm: config.PasswordHashArgon2id, Argon2Time: 1, Argon2Memory: 64, Argon2Threads: 0}, wantErr: true},
+		{name: "argon2 too many threads", cfg: config.PasswordHashCfg{Algorithm: config.PasswordHashArgon2id, Argon2Time: 1, Argon2Memory: 8 * 256, Argon2Threads: 256}, wantErr: true},
+		{name: "argon2 memory below 8 KiB per thread", cfg: config.PasswordHashCfg{Algorithm: config.PasswordHashArgon2id, Argon2Time: 1, Argon2Memory: 15, Argon2Threads: 2}, wantErr: true},
+		{name: "argon2 minimal memory", cfg: config.PasswordHashCfg{Algorithm: config.PasswordHashArgon2id, Argon2Time: 1, Argon2Memory: 16, Argon2Threads: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewPasswordHasher(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewPasswordHasher() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPasswordHasherCompare(t *testing.T) {
+	bcryptHasher := mustHasher(t, testBcrypt)
+	argon2Hasher := mustHasher(t, testArgon2)
+
+	bcryptHash := mustHash(t, bcryptHasher, "correct horse")
+	argon2Hash := mustHash(t, argon2Hasher, "correct horse")
+
+	tests := []struct {
+		name     string
+		hasher   PasswordHasher
+		hash     []byte
+		password string
+		wantErr  error
+	}{
+		{name: "bcrypt match", hasher: bcryptHasher, hash: bcryptHash, password: "correct horse"},
+		{name: "bcrypt mismatch", hasher: bcryptHasher, hash: bcryptHash, password: "wrong horse", wantErr: errPasswordMismatch},
+		{name: "argon2 match", hasher: argon2Hasher, hash: argon2Hash, password: "correct horse"},
+		{name: "argon2 mismatch", hasher: argon2Hasher, hash: argon2Hash, password: "wrong horse", wantErr: errPasswordMismatch},
+		// Hashes record their own algorithm, so either hasher verifies both.
+		{name: "bcrypt hash with argon2 hasher", hasher: argon2Hasher, hash: bcryptHash, password: "correct horse"},
+		{name: "argon2 hash with bcrypt hasher", hasher: bcryptHasher, hash: argon2Hash, password: "correct horse"},
+		{name: "malformed argon2 hash", hasher: argon2Hasher, hash: []byte("$argon2id$v=19$broken"), password: "correct horse", wantErr: errUnknownHash},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.hasher.Compare(tt.hash, tt.password)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("Compare() error = %v, want nil", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Compare() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPasswordHasherNeedsRehash(t *testing.T) {
+	bcryptHasher := mustHasher(t, testBcrypt)
+	strongerBcrypt := mustHasher(t, config.PasswordHashCfg{Algorithm: config.PasswordHashBcrypt, BcryptCost: bcrypt.MinCost + 1})
+	argon2Hasher := mustHasher(t, testArgon2)
+	strongerArgon2 := mustHasher(t, config.PasswordHashCfg{Algorithm: config.PasswordHashArgon2id, Argon2Time: 2, Argon2Memory: 64, Argon2Threads: 1})
+
+	bcryptHash := mustHash(t, bcryptHasher, "correct horse")
+	argon2Hash := mustHash(t, argon2Hasher, "correct horse")
+
+	tests := []struct {
+		name   string
+		hasher PasswordHasher
+		hash   []byte
+		want   bool
+	}{
+		{name: "bcrypt same cost", hasher: bcryptHasher, hash: bcryptHash, want: false},
+		{name: "bcrypt higher cost configured", hasher: strongerBcrypt, hash: bcryptHash, want: true},
+		{name: "bcrypt hash, argon2 configured", hasher: argon2Hasher, hash: bcryptHash, want: true},
+		{name: "argon2 same params", hasher: argon2Hasher, hash: argon2Hash, want: false},
+		{name: "argon2 stronger params configured", hasher: strongerArgon2, hash: argon2Hash, want: true},
+		{name: "argon2 hash, bcrypt configured", hasher: bcryptHasher, hash: argon2Hash, want: true},
+		{name: "unknown hash", hasher: bcryptHasher, hash: []byte("plain"), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hasher.NeedsRehash(tt.hash); got != tt.want {
+				t.Errorf("NeedsRehash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
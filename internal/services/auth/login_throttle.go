@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	sl "mod1/internal/lib/logger"
+	"mod1/internal/storage"
+	"strings"
+	"time"
+)
+
+var ErrLoginLocked = errors.New("too many failed login attempts")
+
+// LockoutError is returned by Login while the account or the client IP
+// is locked. It matches ErrLoginLocked with errors.Is.
+type LockoutError struct {
+	Until time.Time
+}
+
+func (e *LockoutError) Error() string {
+	return fmt.Sprintf("%s: locked until %s", ErrLoginLocked, e.Until.Format(time.RFC3339))
+}
+
+func (e *LockoutError) Unwrap() error {
+	return ErrLoginLocked
+}
+
+type LoginThrottleStorage interface {
+	GetLoginLock(ctx context.Context, key string) (time.Time, error)
+	RecordLoginFailure(ctx context.Context, key string, window time.Duration) (int, error)
+	LockLogin(ctx context.Context, key string, until time.Time) error
+	ResetLoginFailures(ctx context.Context, key string) error
+}
+
+// UnlockUser lifts the login lockout of the account. Locks of client
+// IPs are not affected.
+func (a *Auth) UnlockUser(ctx context.Context, userID int64) error {
+	const op = "Auth.UnlockUser"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.Int64("user_id", userID),
+	)
+
+	user, err := a.usrProvider.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", sl.Err(err))
+			return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to get user", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.throttle.ResetLoginFailures(ctx, accountThrottleKey(user.Email)); err != nil {
+		log.Error("failed to reset login failures", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("user unlocked")
+
+	return nil
+}
+
+// checkLoginLock returns *LockoutError if the account or the IP is locked.
+func (a *Auth) checkLoginLock(ctx context.Context, email, ip string) error {
+	var until time.Time
+	for _, key := range throttleKeys(email, ip) {
+		lockedUntil, err := a.throttle.GetLoginLock(ctx, key)
+		if err != nil {
+			return fmt.Errorf("get login lock: %w", err)
+		}
+		if lockedUntil.After(until) {
+			until = lockedUntil
+		}
+	}
+
+	if until.After(time.Now()) {
+		return &LockoutError{Until: until}
+	}
+
+	return nil
+}
+
+// recordLoginFailure counts a failed attempt and locks keys that reached
+// their limit. Errors are only logged: they must not change the result
+// of the login attempt.
+func (a *Auth) recordLoginFailure(ctx context.Context, log *slog.Logger, email, ip string) {
+	cfg := a.cfg.Lockout
+
+	limits := map[string]int{accountThrottleKey(email): cfg.MaxAccountFailures}
+	if ip != "" {
+		limits[ipThrottleKey(ip)] = cfg.MaxIPFailures
+	}
+
+	for key, limit := range limits {
+		failures, err := a.throttle.RecordLoginFailure(ctx, key, cfg.Window)
+		if err != nil {
+			log.Error("failed to record login failure", sl.Err(err))
+			continue
+		}
+
+		if limit <= 0 || failures < limit {
+			continue
+		}
+
+		lock := lockoutDuration(failures-limit, cfg.BaseLockout, cfg.MaxLockout)
+		if err := a.throttle.LockLogin(ctx, key, time.Now().Add(lock)); err != nil {
+			log.Error("failed to lock login", sl.Err(err))
+			continue
+		}
+
+		log.Warn("login locked", slog.String("key", key), slog.Int("failures", failures), slog.Duration("duration", lock))
+	}
+}
+
+// throttledCheck runs check, an MFA or password check of a signed-in user,
+// under the login throttle, so that a stolen access token alone cannot be
+// used to guess the code: locked accounts and IPs are refused and wrong
+// codes count as failed logins.
+func (a *Auth) throttledCheck(ctx context.Context, log *slog.Logger, email, ip string, check func() error) error {
+	if err := a.checkLoginLock(ctx, email, ip); err != nil {
+		return err
+	}
+
+	err := check()
+	if errors.Is(err, ErrInvalidMfaCode) || errors.Is(err, ErrInvalidCredentials) {
+		a.recordLoginFailure(ctx, log, email, ip)
+	}
+
+	return err
+}
+
+func (a *Auth) resetLoginFailures(ctx context.Context, log *slog.Logger, email string) {
+	if err := a.throttle.ResetLoginFailures(ctx, accountThrottleKey(email)); err != nil {
+		log.Error("failed to reset login failures", sl.Err(err))
+	}
+}
+
+// lockoutDuration doubles base for every failure over the limit, capped at max.
+func lockoutDuration(over int, base, max time.Duration) time.Duration {
+	lock := base
+	for i := 0; i < over && lock < max; i++ {
+		lock *= 2
+	}
+	if lock > max {
+		lock = max
+	}
+
+	return lock
+}
+
+func throttleKeys(email, ip string) []string {
+	keys := []string{accountThrottleKey(email)}
+	if ip != "" {
+		keys = append(keys, ipThrottleKey(ip))
+	}
+
+	return keys
+}
+
+func accountThrottleKey(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func ipThrottleKey(ip string) string {
+	return "ip:" + ip
+}
@@ -0,0 +1,226 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"mod1/config"
+	"mod1/internal/lib/totp"
+	"mod1/internal/models"
+	"mod1/internal/storage"
+	"testing"
+	"time"
+)
+
+// Fakes embed the storage interfaces; methods the tests do not expect panic.
+
+type fakeUsers struct {
+	UserProvider
+	users map[int64]models.User
+}
+
+func (f *fakeUsers) GetUserByID(_ context.Context, userID int64) (models.User, error) {
+	user, ok := f.users[userID]
+	if !ok {
+		return models.User{}, storage.ErrUserNotFound
+	}
+	return user, nil
+}
+
+type fakeTotp struct {
+	TotpStorage
+	secret   string
+	disabled bool
+}
+
+func (f *fakeTotp) GetTotpSecret(context.Context, int64) (string, error) {
+	return f.secret, nil
+}
+
+func (f *fakeTotp) UseTotpStep(context.Context, int64, int64) error {
+	return nil
+}
+
+func (f *fakeTotp) ConsumeRecoveryCode(context.Context, int64, string) error {
+	return storage.ErrRecoveryCodeNotFound
+}
+
+func (f *fakeTotp) DisableTotp(context.Context, int64) error {
+	f.disabled = true
+	return nil
+}
+
+type fakeThrottle struct {
+	failures map[string]int
+	locks    map[string]time.Time
+}
+
+func newFakeThrottle() *fakeThrottle {
+	return &fakeThrottle{failures: map[string]int{}, locks: map[string]time.Time{}}
+}
+
+func (f *fakeThrottle) GetLoginLock(_ context.Context, key string) (time.Time, error) {
+	return f.locks[key], nil
+}
+
+func (f *fakeThrottle) RecordLoginFailure(_ context.Context, key string, _ time.Duration) (int, error) {
+	f.failures[key]++
+	return f.failures[key], nil
+}
+
+func (f *fakeThrottle) LockLogin(_ context.Context, key string, until time.Time) error {
+	f.locks[key] = until
+	return nil
+}
+
+func (f *fakeThrottle) ResetLoginFailures(_ context.Context, key string) error {
+	delete(f.failures, key)
+	delete(f.locks, key)
+	return nil
+}
+
+const testTotpSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// newThrottleTestAuth returns Auth for a single user with TOTP enabled and
+// no password, locking the account after maxFailures wrong codes.
+func newThrottleTestAuth(maxFailures int) (*Auth, *fakeTotp, *fakeThrottle) {
+	user := models.User{ID: 1, Email: "User@Example.com", TotpEnabled: true}
+	totpStore := &fakeTotp{secret: testTotpSecret}
+	throttle := newFakeThrottle()
+
+	a := &Auth{
+		log:         slog.New(slog.NewTextHandler(io.Discard, nil)),
+		usrProvider: &fakeUsers{users: map[int64]models.User{user.ID: user}},
+		totp:        totpStore,
+		throttle:    throttle,
+		cfg: config.AuthCfg{
+			Lockout: config.LockoutCfg{
+				MaxAccountFailures: maxFailures,
+				MaxIPFailures:      100,
+				Window:             time.Minute,
+				BaseLockout:        time.Minute,
+				MaxLockout:         time.Hour,
+			},
+		},
+	}
+
+	return a, totpStore, throttle
+}
+
+func currentCode(t *testing.T) string {
+	t.Helper()
+
+	code, err := totp.Code(testTotpSecret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatalf("totp.Code: %v", err)
+	}
+	return code
+}
+
+// wrongCode returns a well-formed code that does not match any accepted step.
+func wrongCode(t *testing.T) string {
+	t.Helper()
+
+	code, err := totp.Code(testTotpSecret, totp.Step(time.Now())+10)
+	if err != nil {
+		t.Fatalf("totp.Code: %v", err)
+	}
+	return code
+}
+
+func TestMfaChecksLockAccount(t *testing.T) {
+	const maxFailures = 3
+	client := ClientInfo{IP: "203.0.113.7"}
+
+	tests := []struct {
+		name  string
+		check func(a *Auth, code string) error
+	}{
+		{
+			name: "DisableTotp",
+			check: func(a *Auth, code string) error {
+				return a.DisableTotp(context.Background(), 1, code, client)
+			},
+		},
+		{
+			name: "account change without password",
+			check: func(a *Auth, code string) error {
+				_, err := a.confirmUser(context.Background(), a.log, 1, Confirmation{MfaCode: code}, client)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, totpStore, throttle := newThrottleTestAuth(maxFailures)
+
+			for i := 0; i < maxFailures; i++ {
+				if err := tt.check(a, wrongCode(t)); !errors.Is(err, ErrInvalidMfaCode) {
+					t.Fatalf("attempt %d: error = %v, want %v", i+1, err, ErrInvalidMfaCode)
+				}
+			}
+
+			if got := throttle.failures[accountThrottleKey("user@example.com")]; got != maxFailures {
+				t.Errorf("account failures = %d, want %d", got, maxFailures)
+			}
+
+			// Even the right code is refused while the account is locked.
+			err := tt.check(a, currentCode(t))
+			var lockErr *LockoutError
+			if !errors.As(err, &lockErr) {
+				t.Fatalf("error after %d failures = %v, want *LockoutError", maxFailures, err)
+			}
+			if !lockErr.Until.After(time.Now()) {
+				t.Errorf("locked until %s, want a time in the future", lockErr.Until)
+			}
+			if totpStore.disabled {
+				t.Error("totp was disabled while the account is locked")
+			}
+		})
+	}
+}
+
+func TestPasswordConfirmationLocksAccount(t *testing.T) {
+	const maxFailures = 3
+	client := ClientInfo{IP: "203.0.113.7"}
+
+	a, _, _ := newThrottleTestAuth(maxFailures)
+	a.hasher = mustHasher(t, testBcrypt)
+	users := a.usrProvider.(*fakeUsers)
+	user := users.users[1]
+	user.PassHash = mustHash(t, a.hasher, "correct horse")
+	users.users[1] = user
+
+	confirm := func(password string) error {
+		_, err := a.confirmUser(context.Background(), a.log, 1, Confirmation{Password: password}, client)
+		return err
+	}
+
+	for i := 0; i < maxFailures; i++ {
+		if err := confirm("wrong horse"); !errors.Is(err, ErrInvalidCredentials) {
+			t.Fatalf("attempt %d: error = %v, want %v", i+1, err, ErrInvalidCredentials)
+		}
+	}
+
+	var lockErr *LockoutError
+	if err := confirm("correct horse"); !errors.As(err, &lockErr) {
+		t.Fatalf("error after %d failures = %v, want *LockoutError", maxFailures, err)
+	}
+}
+
+func TestMfaCheckBelowLimit(t *testing.T) {
+	a, totpStore, _ := newThrottleTestAuth(3)
+	client := ClientInfo{IP: "203.0.113.7"}
+
+	if err := a.DisableTotp(context.Background(), 1, wrongCode(t), client); !errors.Is(err, ErrInvalidMfaCode) {
+		t.Fatalf("DisableTotp with wrong code: error = %v, want %v", err, ErrInvalidMfaCode)
+	}
+	if err := a.DisableTotp(context.Background(), 1, currentCode(t), client); err != nil {
+		t.Fatalf("DisableTotp with right code: %v", err)
+	}
+	if !totpStore.disabled {
+		t.Error("totp was not disabled")
+	}
+}
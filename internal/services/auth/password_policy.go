@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"errors"
+	"mod1/internal/lib/password"
+	"strings"
+)
+
+var ErrWeakPassword = errors.New("password does not meet the policy")
+
+// PasswordChecker validates new passwords against the password policy.
+type PasswordChecker interface {
+	Check(password, username, email string) []password.Violation
+}
+
+// PasswordPolicyError lists the policy rules a new password violates.
+type PasswordPolicyError struct {
+	Violations []password.Violation
+}
+
+func (e *PasswordPolicyError) Error() string {
+	reasons := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		reasons = append(reasons, v.Reason)
+	}
+
+	return ErrWeakPassword.Error() + ": " + strings.Join(reasons, ", ")
+}
+
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrWeakPassword
+}
+
+func (a *Auth) checkPasswordPolicy(pass, username, email string) error {
+	if violations := a.passwords.Check(pass, username, email); len(violations) > 0 {
+		return &PasswordPolicyError{Violations: violations}
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	sl "mod1/internal/lib/logger"
+	"mod1/internal/lib/randtoken"
+	"mod1/internal/storage"
+	"net/url"
+	"time"
+)
+
+var ErrInvalidResetToken = errors.New("invalid password reset token")
+
+type PasswordResetStorage interface {
+	SavePasswordResetToken(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error
+	GetPasswordResetToken(ctx context.Context, tokenHash string) (int64, error)
+	ConsumePasswordResetToken(ctx context.Context, tokenHash string) (int64, error)
+	UpdatePassword(ctx context.Context, userID int64, passHash []byte) error
+}
+
+// RequestPasswordReset emails a single-use reset link to the user.
+// Unknown emails are silently ignored so the RPC cannot be used
+// to find out which addresses are registered.
+func (a *Auth) RequestPasswordReset(ctx context.Context, email string) error {
+	const op = "Auth.RequestPasswordReset"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("email", email),
+	)
+
+	user, err := a.usrProvider.GetUserByUsername(ctx, email)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Info("password reset requested for unknown email")
+			return nil
+		}
+
+		log.Error("failed to get user", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user.Disabled {
+		log.Info("password reset requested for disabled user")
+		return nil
+	}
+
+	token, err := randtoken.New()
+	if err != nil {
+		log.Error("failed to generate reset token", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	expiresAt := time.Now().Add(a.cfg.PasswordResetTTL)
+	if err := a.resetTokens.SavePasswordResetToken(ctx, user.ID, randtoken.Hash(token), expiresAt); err != nil {
+		log.Error("failed to save reset token", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	link := a.cfg.PasswordResetURL + "?token=" + url.QueryEscape(token)
+	body := fmt.Sprintf("Hello, %s!\n\n"+
+		"To reset your password follow the link below:\n%s\n\n"+
+		"The link is valid for %s. If you did not request a reset, ignore this email.\n",
+		user.Username, link, a.cfg.PasswordResetTTL)
+
+	if err := a.mailer.Send(ctx, user.Email, "Password reset", body); err != nil {
+		log.Error("failed to send reset email", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("password reset email sent", slog.Int64("user_id", user.ID))
+
+	return nil
+}
+
+// ResetPassword sets a new password using a token from RequestPasswordReset.
+// All existing sessions of the user are terminated.
+func (a *Auth) ResetPassword(ctx context.Context, token, newPassword string) error {
+	const op = "Auth.ResetPassword"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	tokenHash := randtoken.Hash(token)
+
+	// The token is checked before the password so that a rejected password
+	// does not use it up.
+	userID, err := a.resetTokens.GetPasswordResetToken(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, storage.ErrResetTokenNotFound) {
+			log.Warn("invalid reset token")
+			return fmt.Errorf("%s: %w", op, ErrInvalidResetToken)
+		}
+
+		log.Error("failed to get reset token", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log = log.With(slog.Int64("user_id", userID))
+
+	user, err := a.usrProvider.GetUserByID(ctx, userID)
+	if err != nil {
+		log.Error("failed to get user", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.checkPasswordPolicy(newPassword, user.Username, user.Email); err != nil {
+		log.Info("new password rejected", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := a.resetTokens.ConsumePasswordResetToken(ctx, tokenHash); err != nil {
+		if errors.Is(err, storage.ErrResetTokenNotFound) {
+			log.Warn("reset token already used")
+			return fmt.Errorf("%s: %w", op, ErrInvalidResetToken)
+		}
+
+		log.Error("failed to consume reset token", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	passHash, err := a.hasher.Hash(newPassword)
+	if err != nil {
+		log.Error("failed to generate password hash", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.resetTokens.UpdatePassword(ctx, userID, passHash); err != nil {
+		log.Error("failed to update password", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.LogoutAll(ctx, userID); err != nil {
+		log.Error("failed to terminate sessions", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("password reset")
+
+	return nil
+}
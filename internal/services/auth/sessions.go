@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	sl "mod1/internal/lib/logger"
+	"mod1/internal/models"
+	"mod1/internal/storage"
+	"time"
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// ClientInfo describes the device a login request came from.
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
+type SessionStorage interface {
+	CreateSession(ctx context.Context, userID int64, userAgent, ip string) (int64, error)
+	UpdateSessionToken(ctx context.Context, sessionID int64, jti string, expiresAt time.Time) error
+	ListSessions(ctx context.Context, userID int64, activeSince time.Time) ([]models.Session, error)
+	GetSessionByToken(ctx context.Context, userID int64, jti string) (models.Session, error)
+	RevokeSession(ctx context.Context, userID, sessionID int64) (string, error)
+	RevokeSessionByToken(ctx context.Context, userID int64, jti string) error
+	RevokeUserSessions(ctx context.Context, userID int64) error
+	TouchSession(ctx context.Context, userID int64, jti string) error
+}
+
+// ListSessions returns active sessions of the user. Sessions not refreshed
+// for longer than the refresh token lifetime can't be used anymore and are
+// omitted.
+func (a *Auth) ListSessions(ctx context.Context, userID int64) ([]models.Session, error) {
+	const op = "Auth.ListSessions"
+
+	sessions, err := a.sessions.ListSessions(ctx, userID, time.Now().Add(-a.cfg.RefreshTokenTTL))
+	if err != nil {
+		a.log.Error("failed to list sessions", slog.String("op", op), sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession signs the user out on one device: refresh tokens of the
+// session and all access tokens issued for it stop working.
+func (a *Auth) RevokeSession(ctx context.Context, userID, sessionID int64) error {
+	const op = "Auth.RevokeSession"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.Int64("user_id", userID),
+		slog.Int64("session_id", sessionID),
+	)
+
+	jti, err := a.sessions.RevokeSession(ctx, userID, sessionID)
+	if err != nil {
+		if errors.Is(err, storage.ErrSessionNotFound) {
+			log.Warn("session not found")
+			return fmt.Errorf("%s: %w", op, ErrSessionNotFound)
+		}
+
+		log.Error("failed to revoke session", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if jti != "" {
+		// Access tokens live at most TokenTTL, so the entry can be dropped after that.
+		if err := a.revoker.RevokeToken(ctx, jti, userID, time.Now().Add(a.cfg.TokenTTL)); err != nil {
+			log.Error("failed to revoke access token", sl.Err(err))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	log.Info("session revoked")
+
+	return nil
+}
+
+func (a *Auth) startSession(ctx context.Context, userID int64, client ClientInfo) (int64, error) {
+	sessionID, err := a.sessions.CreateSession(ctx, userID, client.UserAgent, client.IP)
+	if err != nil {
+		return 0, fmt.Errorf("create session: %w", err)
+	}
+
+	return sessionID, nil
+}
@@ -0,0 +1,220 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	sl "mod1/internal/lib/logger"
+	"mod1/internal/lib/oidc"
+	"mod1/internal/lib/randtoken"
+	"mod1/internal/models"
+	"mod1/internal/storage"
+	"strings"
+	"time"
+)
+
+// provisionAttempts limits retries with another username when the
+// preferred one is taken.
+const provisionAttempts = 3
+
+var (
+	ErrSsoDisabled       = errors.New("sso is not configured")
+	ErrInvalidSsoState   = errors.New("invalid sso state")
+	ErrSsoRejected       = errors.New("identity provider response rejected")
+	ErrSsoNoAccount      = errors.New("no account for this identity")
+	ErrSsoLinkUnverified = errors.New("existing account email is not verified")
+)
+
+type SsoStorage interface {
+	SaveSsoState(ctx context.Context, stateHash, codeVerifier, nonce string, expiresAt time.Time) error
+	ConsumeSsoState(ctx context.Context, stateHash string) (string, string, error)
+	GetUserByIdentity(ctx context.Context, provider, subject string) (models.User, error)
+	LinkIdentity(ctx context.Context, userID int64, provider, subject string) error
+	CreateSsoUser(ctx context.Context, username, email, provider, subject string) (int64, error)
+}
+
+// IdentityProvider is an external OpenID Connect provider.
+type IdentityProvider interface {
+	Name() string
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	Exchange(ctx context.Context, code, codeVerifier string) (oidc.Identity, error)
+}
+
+// StartSso begins the authorization code flow and returns the provider URL
+// the user has to visit. State, nonce and the PKCE verifier are kept on the
+// server, so the flow can't be completed by anyone who did not start it.
+func (a *Auth) StartSso(ctx context.Context) (string, error) {
+	const op = "Auth.StartSso"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	if a.idp == nil {
+		return "", fmt.Errorf("%s: %w", op, ErrSsoDisabled)
+	}
+
+	state, err := randtoken.New()
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	nonce, err := randtoken.New()
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	verifier, err := randtoken.New()
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	authURL, err := a.idp.AuthCodeURL(ctx, state, nonce, oidc.CodeChallenge(verifier))
+	if err != nil {
+		log.Error("failed to build authorization url", sl.Err(err))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	expiresAt := time.Now().Add(a.cfg.SSO.StateTTL)
+	if err := a.sso.SaveSsoState(ctx, randtoken.Hash(state), verifier, nonce, expiresAt); err != nil {
+		log.Error("failed to save sso state", sl.Err(err))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return authURL, nil
+}
+
+// CompleteSso finishes the flow started by StartSso. The user is found by
+// linked identity, then by verified email (and linked), or provisioned if
+// cfg.SSO.AutoProvision is set.
+func (a *Auth) CompleteSso(ctx context.Context, code, state string, client ClientInfo) (LoginResult, error) {
+	const op = "Auth.CompleteSso"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("ip", client.IP),
+	)
+
+	if a.idp == nil {
+		return LoginResult{}, fmt.Errorf("%s: %w", op, ErrSsoDisabled)
+	}
+
+	verifier, nonce, err := a.sso.ConsumeSsoState(ctx, randtoken.Hash(state))
+	if err != nil {
+		if errors.Is(err, storage.ErrSsoStateNotFound) {
+			log.Warn("unknown or expired sso state")
+			return LoginResult{}, fmt.Errorf("%s: %w", op, ErrInvalidSsoState)
+		}
+
+		log.Error("failed to consume sso state", sl.Err(err))
+		return LoginResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	identity, err := a.idp.Exchange(ctx, code, verifier)
+	if err != nil {
+		log.Warn("code exchange failed", sl.Err(err))
+		return LoginResult{}, fmt.Errorf("%s: %w: %w", op, ErrSsoRejected, err)
+	}
+
+	if identity.Nonce != nonce {
+		log.Warn("id token nonce mismatch")
+		return LoginResult{}, fmt.Errorf("%s: %w: nonce mismatch", op, ErrSsoRejected)
+	}
+
+	log = log.With(slog.String("subject", identity.Subject))
+
+	user, err := a.ssoUser(ctx, log, identity)
+	if err != nil {
+		return LoginResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result, err := a.finishLogin(ctx, log, user, client)
+	if err != nil {
+		return LoginResult{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// ssoUser resolves the local user for an identity asserted by the provider.
+func (a *Auth) ssoUser(ctx context.Context, log *slog.Logger, identity oidc.Identity) (models.User, error) {
+	provider := a.idp.Name()
+
+	user, err := a.sso.GetUserByIdentity(ctx, provider, identity.Subject)
+	if err == nil {
+		return user, nil
+	}
+	if !errors.Is(err, storage.ErrUserNotFound) {
+		log.Error("failed to get user by identity", sl.Err(err))
+		return models.User{}, err
+	}
+
+	// Linking by email is only safe if the provider vouches for it.
+	if identity.Email == "" || !identity.EmailVerified {
+		log.Warn("identity has no verified email")
+		return models.User{}, fmt.Errorf("%w: email is missing or not verified", ErrSsoRejected)
+	}
+
+	user, err = a.usrProvider.GetUserByUsername(ctx, identity.Email)
+	switch {
+	case err == nil:
+		// Anyone can register with someone else's address; linking such an
+		// account would keep the registrant's password working on it.
+		if !user.EmailVerified {
+			log.Warn("existing account email is not verified, identity not linked", slog.Int64("user_id", user.ID))
+			return models.User{}, ErrSsoLinkUnverified
+		}
+
+		if err := a.sso.LinkIdentity(ctx, user.ID, provider, identity.Subject); err != nil {
+			log.Error("failed to link identity", sl.Err(err))
+			return models.User{}, err
+		}
+
+		log.Info("identity linked to existing user", slog.Int64("user_id", user.ID))
+
+		return user, nil
+	case !errors.Is(err, storage.ErrUserNotFound):
+		log.Error("failed to get user", sl.Err(err))
+		return models.User{}, err
+	}
+
+	if !a.cfg.SSO.AutoProvision {
+		log.Info("no account for identity and auto-provisioning is off")
+		return models.User{}, ErrSsoNoAccount
+	}
+
+	userID, err := a.provisionSsoUser(ctx, provider, identity)
+	if err != nil {
+		log.Error("failed to provision user", sl.Err(err))
+		return models.User{}, err
+	}
+
+	log.Info("user provisioned from identity provider", slog.Int64("user_id", userID))
+
+	return a.usrProvider.GetUserByID(ctx, userID)
+}
+
+// provisionSsoUser creates a user named after the identity, adding a random
+// suffix if the name is taken.
+func (a *Auth) provisionSsoUser(ctx context.Context, provider string, identity oidc.Identity) (int64, error) {
+	base := identity.Username
+	if base == "" {
+		base, _, _ = strings.Cut(identity.Email, "@")
+	}
+
+	username := base
+	for i := 0; ; i++ {
+		userID, err := a.sso.CreateSsoUser(ctx, username, identity.Email, provider, identity.Subject)
+		if err == nil {
+			return userID, nil
+		}
+		if !errors.Is(err, storage.ErrUserExists) || i+1 == provisionAttempts {
+			return 0, err
+		}
+
+		suffix, err := randtoken.New()
+		if err != nil {
+			return 0, err
+		}
+		username = base + "-" + strings.ToLower(suffix[:6])
+	}
+}
@@ -0,0 +1,361 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"log/slog"
+	sl "mod1/internal/lib/logger"
+	"mod1/internal/lib/randtoken"
+	"mod1/internal/lib/totp"
+	"mod1/internal/models"
+	"mod1/internal/storage"
+	"strings"
+	"time"
+)
+
+const (
+	recoveryCodeCount = 10
+	recoveryCodeBytes = 5
+	maxMfaAttempts    = 5
+)
+
+var (
+	ErrTotpAlreadyEnabled = errors.New("totp is already enabled")
+	ErrTotpNotEnrolled    = errors.New("totp enrollment was not started")
+	ErrTotpNotEnabled     = errors.New("totp is not enabled")
+	ErrInvalidMfaCode     = errors.New("invalid mfa code")
+	ErrInvalidMfaToken    = errors.New("invalid mfa token")
+)
+
+type TotpStorage interface {
+	SetTotpSecret(ctx context.Context, userID int64, secret string) error
+	GetTotpSecret(ctx context.Context, userID int64) (string, error)
+	EnableTotp(ctx context.Context, userID int64, recoveryCodeHashes []string) error
+	DisableTotp(ctx context.Context, userID int64) error
+	UseTotpStep(ctx context.Context, userID int64, step int64) error
+	ConsumeRecoveryCode(ctx context.Context, userID int64, codeHash string) error
+	SaveMfaChallenge(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error
+	GetMfaChallenge(ctx context.Context, tokenHash string, maxAttempts int) (int64, error)
+	RecordMfaFailure(ctx context.Context, tokenHash string) error
+	ConsumeMfaChallenge(ctx context.Context, tokenHash string) error
+}
+
+type TotpEnrollment struct {
+	Secret string
+	URI    string // otpauth:// URI for authenticator apps
+}
+
+// EnrollTotp generates a new secret for the user. TOTP is not required on
+// login until the secret is confirmed with ConfirmTotp.
+func (a *Auth) EnrollTotp(ctx context.Context, userID int64) (TotpEnrollment, error) {
+	const op = "Auth.EnrollTotp"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.Int64("user_id", userID),
+	)
+
+	user, err := a.usrProvider.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return TotpEnrollment{}, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to get user", sl.Err(err))
+		return TotpEnrollment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user.TotpEnabled {
+		return TotpEnrollment{}, fmt.Errorf("%s: %w", op, ErrTotpAlreadyEnabled)
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		log.Error("failed to generate totp secret", sl.Err(err))
+		return TotpEnrollment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.totp.SetTotpSecret(ctx, userID, secret); err != nil {
+		log.Error("failed to save totp secret", sl.Err(err))
+		return TotpEnrollment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("totp enrollment started")
+
+	return TotpEnrollment{
+		Secret: secret,
+		URI:    totp.URI(a.cfg.TotpIssuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmTotp enables TOTP after the user proves the authenticator app is
+// set up. It returns recovery codes; they are shown only once.
+func (a *Auth) ConfirmTotp(ctx context.Context, userID int64, code string) ([]string, error) {
+	const op = "Auth.ConfirmTotp"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.Int64("user_id", userID),
+	)
+
+	user, err := a.usrProvider.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to get user", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user.TotpEnabled {
+		return nil, fmt.Errorf("%s: %w", op, ErrTotpAlreadyEnabled)
+	}
+
+	secret, err := a.totp.GetTotpSecret(ctx, userID)
+	if err != nil {
+		log.Error("failed to get totp secret", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if secret == "" {
+		return nil, fmt.Errorf("%s: %w", op, ErrTotpNotEnrolled)
+	}
+
+	if err := a.checkTotpCode(ctx, userID, secret, code); err != nil {
+		log.Info("invalid totp code on confirmation")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		log.Error("failed to generate recovery codes", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.totp.EnableTotp(ctx, userID, hashes); err != nil {
+		log.Error("failed to enable totp", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("totp enabled")
+
+	return codes, nil
+}
+
+// DisableTotp turns TOTP off. A current TOTP code or an unused recovery
+// code is required, so a stolen access token alone is not enough.
+func (a *Auth) DisableTotp(ctx context.Context, userID int64, code string, client ClientInfo) error {
+	const op = "Auth.DisableTotp"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.Int64("user_id", userID),
+	)
+
+	user, err := a.usrProvider.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to get user", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !user.TotpEnabled {
+		return fmt.Errorf("%s: %w", op, ErrTotpNotEnabled)
+	}
+
+	err = a.throttledCheck(ctx, log, user.Email, client.IP, func() error {
+		return a.checkSecondFactor(ctx, userID, code)
+	})
+	if err != nil {
+		log.Info("mfa check on disable failed", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.totp.DisableTotp(ctx, userID); err != nil {
+		log.Error("failed to disable totp", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("totp disabled")
+
+	return nil
+}
+
+// VerifyMfa completes a login started with Login by exchanging the MFA
+// challenge token and a TOTP or recovery code for a token pair.
+func (a *Auth) VerifyMfa(ctx context.Context, mfaToken string, code string, client ClientInfo) (TokenPair, error) {
+	const op = "Auth.VerifyMfa"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	tokenHash := randtoken.Hash(mfaToken)
+
+	userID, err := a.totp.GetMfaChallenge(ctx, tokenHash, maxMfaAttempts)
+	if err != nil {
+		if errors.Is(err, storage.ErrMfaChallengeNotFound) {
+			log.Warn("mfa challenge not found")
+			return TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidMfaToken)
+		}
+
+		log.Error("failed to get mfa challenge", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log = log.With(slog.Int64("user_id", userID))
+
+	user, err := a.usrProvider.GetUserByID(ctx, userID)
+	if err != nil {
+		log.Error("failed to get user", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Wrong codes count in the login throttle too: maxMfaAttempts limits
+	// only one challenge, and a new one is a Login call away.
+	if err := a.checkLoginLock(ctx, user.Email, client.IP); err != nil {
+		var lockErr *LockoutError
+		if errors.As(err, &lockErr) {
+			log.Warn("mfa attempt while locked", slog.Time("locked_until", lockErr.Until))
+		} else {
+			log.Error("failed to check login lock", sl.Err(err))
+		}
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.checkSecondFactor(ctx, userID, code); err != nil {
+		if errors.Is(err, ErrInvalidMfaCode) {
+			log.Info("invalid mfa code")
+			if err := a.totp.RecordMfaFailure(ctx, tokenHash); err != nil {
+				log.Error("failed to record mfa failure", sl.Err(err))
+			}
+			a.recordLoginFailure(ctx, log, user.Email, client.IP)
+		}
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.totp.ConsumeMfaChallenge(ctx, tokenHash); err != nil {
+		if errors.Is(err, storage.ErrMfaChallengeNotFound) {
+			log.Warn("mfa challenge was used concurrently")
+			return TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidMfaToken)
+		}
+
+		log.Error("failed to consume mfa challenge", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	a.resetLoginFailures(ctx, log, user.Email)
+
+	if user.Disabled {
+		log.Warn("mfa attempt for disabled user")
+		return TokenPair{}, fmt.Errorf("%s: %w", op, ErrUserDisabled)
+	}
+
+	sessionID, err := a.startSession(ctx, user.ID, client)
+	if err != nil {
+		log.Error("failed to start session", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pair, err := a.issueTokens(ctx, user, sessionID)
+	if err != nil {
+		log.Error("failed to generate tokens", sl.Err(err))
+		return TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("user logged in successfully")
+
+	return pair, nil
+}
+
+// startMfaChallenge issues a short-lived token that proves the password
+// was already checked.
+func (a *Auth) startMfaChallenge(ctx context.Context, user models.User) (string, error) {
+	token, err := randtoken.New()
+	if err != nil {
+		return "", fmt.Errorf("generate mfa token: %w", err)
+	}
+
+	expiresAt := time.Now().Add(a.cfg.MfaChallengeTTL)
+	if err := a.totp.SaveMfaChallenge(ctx, user.ID, randtoken.Hash(token), expiresAt); err != nil {
+		return "", fmt.Errorf("save mfa challenge: %w", err)
+	}
+
+	return token, nil
+}
+
+// checkSecondFactor accepts either a TOTP code or an unused recovery code.
+func (a *Auth) checkSecondFactor(ctx context.Context, userID int64, code string) error {
+	code = strings.TrimSpace(code)
+
+	if len(code) == totp.Digits {
+		secret, err := a.totp.GetTotpSecret(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("get totp secret: %w", err)
+		}
+		if secret == "" {
+			return ErrTotpNotEnabled
+		}
+
+		return a.checkTotpCode(ctx, userID, secret, code)
+	}
+
+	err := a.totp.ConsumeRecoveryCode(ctx, userID, randtoken.Hash(normalizeRecoveryCode(code)))
+	if err != nil {
+		if errors.Is(err, storage.ErrRecoveryCodeNotFound) {
+			return ErrInvalidMfaCode
+		}
+		return fmt.Errorf("consume recovery code: %w", err)
+	}
+
+	a.log.Info("recovery code used", slog.Int64("user_id", userID))
+
+	return nil
+}
+
+func (a *Auth) checkTotpCode(ctx context.Context, userID int64, secret, code string) error {
+	step, ok := totp.Validate(code, secret, time.Now())
+	if !ok {
+		return ErrInvalidMfaCode
+	}
+
+	if err := a.totp.UseTotpStep(ctx, userID, step); err != nil {
+		if errors.Is(err, storage.ErrTotpStepUsed) {
+			return ErrInvalidMfaCode
+		}
+		return fmt.Errorf("use totp step: %w", err)
+	}
+
+	return nil
+}
+
+// generateRecoveryCodes returns plain codes formatted as xxxx-xxxx and their hashes.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+
+	b := make([]byte, recoveryCodeBytes)
+	for i := 0; i < recoveryCodeCount; i++ {
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+
+		raw := strings.ToLower(base32.StdEncoding.EncodeToString(b))
+		codes = append(codes, raw[:4]+"-"+raw[4:])
+		hashes = append(hashes, randtoken.Hash(raw))
+	}
+
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrDependencyCycle    = errors.New("dependency would create a cycle")
+	ErrDependencyExists   = errors.New("dependency already exists")
+	ErrDependencyNotFound = errors.New("dependency not found")
+	ErrTaskBlocked        = errors.New("task is blocked by open tasks")
+)
+
+// AddDependency marks taskID as blocked by blockedByID.
+func (s *TaskService) AddDependency(ctx context.Context, userID, taskID, blockedByID int64) error {
+	const op = "TaskService.AddDependency"
+
+	if err := s.storage.AddDependency(ctx, userID, taskID, blockedByID); err != nil {
+		return fmt.Errorf("%s: %w", op, mapTaskError(err))
+	}
+
+	return nil
+}
+
+func (s *TaskService) RemoveDependency(ctx context.Context, userID, taskID, blockedByID int64) error {
+	const op = "TaskService.RemoveDependency"
+
+	if err := s.storage.RemoveDependency(ctx, userID, taskID, blockedByID); err != nil {
+		return fmt.Errorf("%s: %w", op, mapTaskError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mod1/internal/models"
+	"strings"
+)
+
+var (
+	ErrAccessDenied   = errors.New("access denied")
+	ErrMemberNotFound = errors.New("member not found")
+	ErrUserNotFound   = errors.New("user not found")
+	ErrMemberIsOwner  = errors.New("the owner cannot be added as a member")
+	ErrInvalidRole    = errors.New("role must be viewer, editor or owner")
+)
+
+// ShareTask gives the user with the email the role on the task, replacing
+// the role they already have.
+func (s *TaskService) ShareTask(ctx context.Context, userID, taskID int64, email string, role models.MemberRole) (models.Member, error) {
+	const op = "TaskService.ShareTask"
+
+	if err := validateRole(role); err != nil {
+		return models.Member{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	member, err := s.storage.ShareTask(ctx, userID, taskID, strings.TrimSpace(email), role)
+	if err != nil {
+		return models.Member{}, fmt.Errorf("%s: %w", op, mapTaskError(err))
+	}
+
+	return member, nil
+}
+
+func (s *TaskService) UnshareTask(ctx context.Context, userID, taskID, memberID int64) error {
+	const op = "TaskService.UnshareTask"
+
+	if err := s.storage.UnshareTask(ctx, userID, taskID, memberID); err != nil {
+		return fmt.Errorf("%s: %w", op, mapTaskError(err))
+	}
+
+	return nil
+}
+
+func (s *TaskService) ListTaskMembers(ctx context.Context, userID, taskID int64) ([]models.Member, error) {
+	const op = "TaskService.ListTaskMembers"
+
+	members, err := s.storage.ListTaskMembers(ctx, userID, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, mapTaskError(err))
+	}
+
+	return members, nil
+}
+
+// ShareProject gives the user with the email the role on the project and
+// every task in it.
+func (s *TaskService) ShareProject(ctx context.Context, userID, projectID int64, email string, role models.MemberRole) (models.Member, error) {
+	const op = "TaskService.ShareProject"
+
+	if err := validateRole(role); err != nil {
+		return models.Member{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	member, err := s.storage.ShareProject(ctx, userID, projectID, strings.TrimSpace(email), role)
+	if err != nil {
+		return models.Member{}, fmt.Errorf("%s: %w", op, mapTaskError(err))
+	}
+
+	return member, nil
+}
+
+func (s *TaskService) UnshareProject(ctx context.Context, userID, projectID, memberID int64) error {
+	const op = "TaskService.UnshareProject"
+
+	if err := s.storage.UnshareProject(ctx, userID, projectID, memberID); err != nil {
+		return fmt.Errorf("%s: %w", op, mapTaskError(err))
+	}
+
+	return nil
+}
+
+func (s *TaskService) ListProjectMembers(ctx context.Context, userID, projectID int64) ([]models.Member, error) {
+	const op = "TaskService.ListProjectMembers"
+
+	members, err := s.storage.ListProjectMembers(ctx, userID, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, mapTaskError(err))
+	}
+
+	return members, nil
+}
+
+func validateRole(role models.MemberRole) error {
+	switch role {
+	case models.MEMBER_ROLE_VIEWER, models.MEMBER_ROLE_EDITOR, models.MEMBER_ROLE_OWNER:
+		return nil
+	default:
+		return ErrInvalidRole
+	}
+}
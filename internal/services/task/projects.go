@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mod1/internal/models"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxProjectNameLen = 128
+
+var (
+	ErrProjectNotFound    = errors.New("project not found")
+	ErrProjectArchived    = errors.New("project is archived")
+	ErrInvalidProjectName = fmt.Errorf("project name must be 1 to %d characters", maxProjectNameLen)
+	ErrInvalidColor       = errors.New("color must be in #rrggbb format")
+)
+
+var colorRe = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func (s *TaskService) CreateProject(ctx context.Context, userID int64, name, description, color string) (models.Project, error) {
+	const op = "TaskService.CreateProject"
+
+	name, err := normalizeProjectName(name)
+	if err != nil {
+		return models.Project{}, fmt.Errorf("%s: %w", op, err)
+	}
+	color, err = normalizeColor(color)
+	if err != nil {
+		return models.Project{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	project, err := s.storage.CreateProject(ctx, userID, name, description, color)
+	if err != nil {
+		return models.Project{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return project, nil
+}
+
+func (s *TaskService) GetProject(ctx context.Context, userID, projectID int64) (models.Project, error) {
+	const op = "TaskService.GetProject"
+
+	project, err := s.storage.GetProject(ctx, userID, projectID)
+	if err != nil {
+		return models.Project{}, fmt.Errorf("%s: %w", op, mapTaskError(err))
+	}
+
+	return project, nil
+}
+
+func (s *TaskService) ListProjects(ctx context.Context, userID int64, includeArchived bool) ([]models.Project, error) {
+	const op = "TaskService.ListProjects"
+
+	projects, err := s.storage.ListProjects(ctx, userID, includeArchived)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return projects, nil
+}
+
+// UpdateProject changes only the fields set in upd.
+func (s *TaskService) UpdateProject(ctx context.Context, userID, projectID int64, upd models.ProjectUpdate) (models.Project, error) {
+	const op = "TaskService.UpdateProject"
+
+	if upd.Name != nil {
+		name, err := normalizeProjectName(*upd.Name)
+		if err != nil {
+			return models.Project{}, fmt.Errorf("%s: %w", op, err)
+		}
+		upd.Name = &name
+	}
+	if upd.Color != nil {
+		color, err := normalizeColor(*upd.Color)
+		if err != nil {
+			return models.Project{}, fmt.Errorf("%s: %w", op, err)
+		}
+		upd.Color = &color
+	}
+
+	project, err := s.storage.UpdateProject(ctx, userID, projectID, upd)
+	if err != nil {
+		return models.Project{}, fmt.Errorf("%s: %w", op, mapTaskError(err))
+	}
+
+	return project, nil
+}
+
+// DeleteProject deletes the project, keeping its tasks without a project.
+func (s *TaskService) DeleteProject(ctx context.Context, userID, projectID int64) error {
+	const op = "TaskService.DeleteProject"
+
+	if err := s.storage.DeleteProject(ctx, userID, projectID); err != nil {
+		return fmt.Errorf("%s: %w", op, mapTaskError(err))
+	}
+
+	return nil
+}
+
+// MoveTask puts the task into the project, or takes it out of its project
+// if projectID is 0.
+func (s *TaskService) MoveTask(ctx context.Context, userID, taskID, projectID, expectedVersion int64) error {
+	const op = "TaskService.MoveTask"
+
+	upd := models.TaskUpdate{UpdateProject: true, ProjectID: projectID}
+	if err := s.UpdateTask(ctx, userID, taskID, upd, expectedVersion); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func normalizeProjectName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxProjectNameLen {
+		return "", ErrInvalidProjectName
+	}
+	return name, nil
+}
+
+// normalizeColor checks the color and lower-cases it. Empty color is allowed.
+func normalizeColor(color string) (string, error) {
+	if color == "" {
+		return "", nil
+	}
+	if !colorRe.MatchString(color) {
+		return "", ErrInvalidColor
+	}
+	return strings.ToLower(color), nil
+}
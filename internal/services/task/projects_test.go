@@ -0,0 +1,76 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeProjectName(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{name: "plain", in: "Home", want: "Home"},
+		{name: "trimmed", in: "  Home  ", want: "Home"},
+		{name: "empty", in: "", wantErr: true},
+		{name: "only spaces", in: "   ", wantErr: true},
+		{name: "max length in runes", in: strings.Repeat("ж", maxProjectNameLen), want: strings.Repeat("ж", maxProjectNameLen)},
+		{name: "too long", in: strings.Repeat("a", maxProjectNameLen+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeProjectName(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidProjectName) {
+					t.Fatalf("normalizeProjectName(%q) error = %v, want %v", tt.in, err, ErrInvalidProjectName)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeProjectName(%q) error = %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("normalizeProjectName(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeColor(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "", want: ""},
+		{in: "#aabbcc", want: "#aabbcc"},
+		{in: "#AABBCC", want: "#aabbcc"},
+		{in: "#a1B2c3", want: "#a1b2c3"},
+		{in: "aabbcc", wantErr: true},
+		{in: "#abc", wantErr: true},
+		{in: "#aabbccdd", wantErr: true},
+		{in: "#gggggg", wantErr: true},
+		{in: " #aabbcc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := normalizeColor(tt.in)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidColor) {
+				t.Errorf("normalizeColor(%q) error = %v, want %v", tt.in, err, ErrInvalidColor)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("normalizeColor(%q) error = %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("normalizeColor(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mod1/internal/models"
+	"mod1/internal/storage"
+)
+
+const defaultMaxDepth = 5
+
+var (
+	ErrParentNotFound = errors.New("parent task not found")
+	ErrTaskCycle      = errors.New("task cannot be a subtask of itself or of its subtasks")
+	ErrTaskTooDeep    = errors.New("subtasks are nested too deep")
+)
+
+// GetTaskTree returns the task with Subtasks filled at all levels.
+func (s *TaskService) GetTaskTree(ctx context.Context, userID, taskID int64) (*storage.Task, error) {
+	const op = "TaskService.GetTaskTree"
+
+	tasks, err := s.storage.GetTaskTree(ctx, userID, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, mapTaskError(err))
+	}
+
+	return buildTaskTree(tasks), nil
+}
+
+// ListSubtasks returns a page of direct subtasks of the parent task.
+func (s *TaskService) ListSubtasks(ctx context.Context, userID, parentID int64, pageSize, pageToken int32) ([]*storage.Task, error) {
+	const op = "TaskService.ListSubtasks"
+
+	// Пустой список не должен скрывать, что родителя нет.
+	if _, err := s.storage.GetTask(ctx, userID, parentID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, mapTaskError(err))
+	}
+
+	tasks, err := s.storage.ListTasks(ctx, userID, models.TaskFilter{ParentID: &parentID}, pageSize, pageToken)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tasks, nil
+}
+
+// buildTaskTree links tasks to their parents. The first task is the root,
+// the rest are its descendants ordered by ID.
+func buildTaskTree(tasks []*storage.Task) *storage.Task {
+	byID := make(map[int64]*storage.Task, len(tasks))
+	for _, task := range tasks {
+		byID[task.ID] = task
+	}
+
+	root := tasks[0]
+	for _, task := range tasks[1:] {
+		if parent, ok := byID[task.ParentID]; ok {
+			parent.Subtasks = append(parent.Subtasks, task)
+		}
+	}
+
+	return root
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mod1/internal/models"
+	"mod1/internal/storage"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxTagNameLen = 64
+
+var (
+	ErrTagNotFound    = errors.New("tag not found")
+	ErrTagExists      = errors.New("tag already exists")
+	ErrInvalidTagName = fmt.Errorf("tag name must be 1 to %d characters", maxTagNameLen)
+)
+
+func (s *TaskService) CreateTag(ctx context.Context, userID int64, name string) (models.Tag, error) {
+	const op = "TaskService.CreateTag"
+
+	name, err := normalizeTagName(name)
+	if err != nil {
+		return models.Tag{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tag, err := s.storage.CreateTag(ctx, userID, name)
+	if err != nil {
+		return models.Tag{}, fmt.Errorf("%s: %w", op, mapTagError(err))
+	}
+
+	return tag, nil
+}
+
+func (s *TaskService) ListTags(ctx context.Context, userID int64) ([]models.Tag, error) {
+	const op = "TaskService.ListTags"
+
+	tags, err := s.storage.ListTags(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tags, nil
+}
+
+func (s *TaskService) RenameTag(ctx context.Context, userID, tagID int64, name string) (models.Tag, error) {
+	const op = "TaskService.RenameTag"
+
+	name, err := normalizeTagName(name)
+	if err != nil {
+		return models.Tag{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tag, err := s.storage.RenameTag(ctx, userID, tagID, name)
+	if err != nil {
+		return models.Tag{}, fmt.Errorf("%s: %w", op, mapTagError(err))
+	}
+
+	return tag, nil
+}
+
+func (s *TaskService) DeleteTag(ctx context.Context, userID, tagID int64) error {
+	const op = "TaskService.DeleteTag"
+
+	if err := s.storage.DeleteTag(ctx, userID, tagID); err != nil {
+		return fmt.Errorf("%s: %w", op, mapTagError(err))
+	}
+
+	return nil
+}
+
+// normalizeTagName trims the name and checks its length.
+func normalizeTagName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxTagNameLen {
+		return "", ErrInvalidTagName
+	}
+	return name, nil
+}
+
+// normalizeTagNames normalizes names and drops case-insensitive duplicates,
+// keeping the first spelling.
+func normalizeTagNames(names []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(names))
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		name, err := normalizeTagName(name)
+		if err != nil {
+			return nil, err
+		}
+
+		key := strings.ToLower(name)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		result = append(result, name)
+	}
+	return result, nil
+}
+
+func mapTagError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrTagNotFound):
+		return ErrTagNotFound
+	case errors.Is(err, storage.ErrTagExists):
+		return ErrTagExists
+	default:
+		return err
+	}
+}
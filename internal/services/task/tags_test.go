@@ -0,0 +1,44 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeTagNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "nil", in: nil, want: []string{}},
+		{name: "trimmed", in: []string{" work ", "home"}, want: []string{"work", "home"}},
+		{name: "case-insensitive duplicates keep first spelling", in: []string{"Work", "work", "WORK ", "home"}, want: []string{"Work", "home"}},
+		{name: "unicode duplicates", in: []string{"Дом", "дом"}, want: []string{"Дом"}},
+		{name: "max length", in: []string{strings.Repeat("a", maxTagNameLen)}, want: []string{strings.Repeat("a", maxTagNameLen)}},
+		{name: "empty name", in: []string{"work", ""}, wantErr: true},
+		{name: "blank name", in: []string{"  "}, wantErr: true},
+		{name: "too long", in: []string{strings.Repeat("a", maxTagNameLen+1)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeTagNames(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidTagName) {
+					t.Fatalf("normalizeTagNames(%q) error = %v, want %v", tt.in, err, ErrInvalidTagName)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeTagNames(%q) error = %v", tt.in, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeTagNames(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,63 +2,168 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"mod1/config"
 	"mod1/internal/models"
 	"mod1/internal/storage"
-	"time"
+)
+
+var (
+	ErrTaskNotFound    = errors.New("task not found")
+	ErrVersionMismatch = errors.New("task has been modified")
 )
 
 type TaskService struct {
 	storage *storage.Storage
+	// maxDepth limits nesting of subtasks, reparent keeps subtasks of
+	// deleted tasks instead of deleting them.
+	maxDepth int
+	reparent bool
+	// enforceDependencies keeps blocked tasks from being started or completed.
+	enforceDependencies bool
 }
 
-func NewTaskService(storage *storage.Storage) *TaskService {
-	return &TaskService{storage: storage}
+// NewTaskService creates the service. Invalid maxDepth falls back to the
+// default, unknown delete policy to cascade.
+func NewTaskService(storage *storage.Storage, cfg config.TaskCfg) *TaskService {
+	maxDepth := cfg.MaxDepth
+	if maxDepth < 1 {
+		maxDepth = defaultMaxDepth
+	}
+
+	return &TaskService{
+		storage:             storage,
+		maxDepth:            maxDepth,
+		reparent:            cfg.DeleteSubtasks == config.DeleteSubtasksReparent,
+		enforceDependencies: cfg.EnforceDependencies,
+	}
 }
 
-func (s *TaskService) CreateTask(ctx context.Context, userID int64, title, description string, dueDate time.Time, status models.TaskStatus) (int64, error) {
-	var dueDateStr string
-	if !dueDate.IsZero() {
-		dueDateStr = dueDate.Format(time.RFC3339)
+// CreateTask creates a task, in the project if ProjectID is not 0 and as
+// a subtask if ParentID is not 0. Unspecified priority defaults to medium,
+// tags missing among the user's tags are created.
+func (s *TaskService) CreateTask(ctx context.Context, userID int64, t models.NewTask) (int64, error) {
+	const op = "TaskService.CreateTask"
+
+	if t.Priority == models.TASK_PRIORITY_UNSPECIFIED {
+		t.Priority = models.TASK_PRIORITY_MEDIUM
+	}
+
+	tags, err := normalizeTagNames(t.Tags)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	t.Tags = tags
+
+	taskID, err := s.storage.CreateTask(ctx, userID, t, s.maxDepth)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, mapTaskError(err))
 	}
-	return s.storage.CreateTask(ctx, userID, title, description, dueDateStr, int32(status))
+
+	return taskID, nil
 }
 
 func (s *TaskService) GetTask(ctx context.Context, userID, taskID int64) (*storage.Task, error) {
-	return s.storage.GetTask(ctx, userID, taskID)
-}
+	const op = "TaskService.GetTask"
 
-func (s *TaskService) UpdateTask(ctx context.Context, userID, taskID int64, title, description string, dueDate time.Time, status models.TaskStatus) error {
-	var dueDateStr string
-	if !dueDate.IsZero() {
-		dueDateStr = dueDate.Format(time.RFC3339)
+	task, err := s.storage.GetTask(ctx, userID, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, mapTaskError(err))
 	}
-	return s.storage.UpdateTask(ctx, taskID, userID, title, description, dueDateStr, int32(status))
-}
 
-func (s *TaskService) DeleteTask(ctx context.Context, userID, taskID int64) error {
-	return s.storage.DeleteTask(ctx, taskID, userID)
+	return task, nil
 }
 
-func (s *TaskService) ListTasks(ctx context.Context, userID int64, status *models.TaskStatus, dueDateFrom, dueDateTo *time.Time, pageSize, pageToken int32) ([]*storage.Task, error) {
-	var statusInt *int32
-	if status != nil {
-		s := int32(*status)
-		statusInt = &s
+// UpdateTask changes only the fields set in upd. Non-zero expectedVersion
+// makes the update fail with ErrVersionMismatch if the task has changed.
+func (s *TaskService) UpdateTask(ctx context.Context, userID, taskID int64, upd models.TaskUpdate, expectedVersion int64) error {
+	const op = "TaskService.UpdateTask"
+
+	if upd.UpdateTags {
+		tags, err := normalizeTagNames(upd.Tags)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		upd.Tags = tags
 	}
 
-	var fromStr, toStr *string
-	if dueDateFrom != nil {
-		s := dueDateFrom.Format(time.RFC3339)
-		fromStr = &s
+	if upd.UpdateParent && upd.ParentID == taskID {
+		return fmt.Errorf("%s: %w", op, ErrTaskCycle)
 	}
-	if dueDateTo != nil {
-		s := dueDateTo.Format(time.RFC3339)
-		toStr = &s
+	if s.enforceDependencies && upd.Status != nil &&
+		(*upd.Status == models.TASK_STATUS_IN_PROGRESS || *upd.Status == models.TASK_STATUS_COMPLETED) {
+		upd.RequireUnblocked = true
 	}
 
-	return s.storage.ListTasks(ctx, userID, statusInt, fromStr, toStr, pageSize, pageToken)
+	if err := s.storage.UpdateTask(ctx, taskID, userID, upd, expectedVersion, s.maxDepth); err != nil {
+		return fmt.Errorf("%s: %w", op, mapTaskError(err))
+	}
+
+	return nil
+}
+
+// DeleteTask removes the task. Non-zero expectedVersion makes it fail with
+// ErrVersionMismatch if the task has changed. Subtasks are deleted or moved
+// up a level according to the configured policy.
+func (s *TaskService) DeleteTask(ctx context.Context, userID, taskID, expectedVersion int64) error {
+	const op = "TaskService.DeleteTask"
+
+	if err := s.storage.DeleteTask(ctx, taskID, userID, expectedVersion, s.reparent); err != nil {
+		return fmt.Errorf("%s: %w", op, mapTaskError(err))
+	}
+
+	return nil
 }
 
-func (s *TaskService) SearchTasks(ctx context.Context, userID int64, query string, pageSize, pageToken int32) ([]*storage.Task, error) {
-	return s.storage.SearchTasks(ctx, userID, query, pageSize, pageToken)
+func (s *TaskService) ListTasks(ctx context.Context, userID int64, filter models.TaskFilter, pageSize, pageToken int32) ([]*storage.Task, error) {
+	return s.storage.ListTasks(ctx, userID, filter, pageSize, pageToken)
+}
+
+// ListUserTasks returns a page of tasks the user owns, for administrators.
+// Tasks shared with the user are not included.
+func (s *TaskService) ListUserTasks(ctx context.Context, userID int64, status *models.TaskStatus, pageSize, pageToken int32) ([]*storage.Task, error) {
+	filter := models.TaskFilter{Status: status, OwnedOnly: true}
+	return s.storage.ListTasks(ctx, userID, filter, pageSize, pageToken)
+}
+
+func (s *TaskService) SearchTasks(ctx context.Context, userID int64, query string, tags models.TagFilter, pageSize, pageToken int32) ([]*storage.Task, error) {
+	return s.storage.SearchTasks(ctx, userID, query, tags, pageSize, pageToken)
+}
+
+func mapTaskError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrTaskNotFound):
+		return ErrTaskNotFound
+	case errors.Is(err, storage.ErrTaskVersionMismatch):
+		return ErrVersionMismatch
+	case errors.Is(err, storage.ErrProjectNotFound):
+		return ErrProjectNotFound
+	case errors.Is(err, storage.ErrProjectArchived):
+		return ErrProjectArchived
+	case errors.Is(err, storage.ErrParentNotFound):
+		return ErrParentNotFound
+	case errors.Is(err, storage.ErrTaskCycle):
+		return ErrTaskCycle
+	case errors.Is(err, storage.ErrTaskTooDeep):
+		return ErrTaskTooDeep
+	case errors.Is(err, storage.ErrDependencyCycle):
+		return ErrDependencyCycle
+	case errors.Is(err, storage.ErrDependencyExists):
+		return ErrDependencyExists
+	case errors.Is(err, storage.ErrDependencyNotFound):
+		return ErrDependencyNotFound
+	case errors.Is(err, storage.ErrTaskBlocked):
+		return ErrTaskBlocked
+	case errors.Is(err, storage.ErrAccessDenied):
+		return ErrAccessDenied
+	case errors.Is(err, storage.ErrMemberNotFound):
+		return ErrMemberNotFound
+	case errors.Is(err, storage.ErrUserNotFound):
+		return ErrUserNotFound
+	case errors.Is(err, storage.ErrMemberIsOwner):
+		return ErrMemberIsOwner
+	default:
+		return err
+	}
 }
@@ -0,0 +1,154 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"mod1/internal/models"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const apiKeyColumns = "id, user_id, name, prefix, scopes, expires_at, last_used_at, revoked_at, created_at"
+
+func scanApiKey(row rowScanner) (models.ApiKey, error) {
+	var key models.ApiKey
+	var expiresAt, lastUsedAt, revokedAt sql.NullTime
+	err := row.Scan(&key.ID, &key.UserID, &key.Name, &key.Prefix, pq.Array(&key.Scopes),
+		&expiresAt, &lastUsedAt, &revokedAt, &key.CreatedAt)
+	if err != nil {
+		return models.ApiKey{}, err
+	}
+
+	if expiresAt.Valid {
+		key.ExpiresAt = &expiresAt.Time
+	}
+	if lastUsedAt.Valid {
+		key.LastUsedAt = &lastUsedAt.Time
+	}
+	if revokedAt.Valid {
+		key.RevokedAt = &revokedAt.Time
+	}
+
+	return key, nil
+}
+
+func (s *Storage) CreateApiKey(ctx context.Context, userID int64, name, prefix, keyHash string, scopes []string, expiresAt *time.Time) (models.ApiKey, error) {
+	const op = "storage.postgres.CreateApiKey"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"INSERT INTO api_keys (user_id, name, prefix, key_hash, scopes, expires_at) VALUES ($1, $2, $3, $4, $5, $6) "+
+			"RETURNING "+apiKeyColumns)
+	if err != nil {
+		return models.ApiKey{}, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	var nullableExpiresAt sql.NullTime
+	if expiresAt != nil {
+		nullableExpiresAt = sql.NullTime{Time: *expiresAt, Valid: true}
+	}
+
+	key, err := scanApiKey(stmt.QueryRowContext(ctx, userID, name, prefix, keyHash, pq.Array(scopes), nullableExpiresAt))
+	if err != nil {
+		return models.ApiKey{}, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return key, nil
+}
+
+func (s *Storage) GetApiKeyByHash(ctx context.Context, keyHash string) (models.ApiKey, error) {
+	const op = "storage.postgres.GetApiKeyByHash"
+
+	stmt, err := s.db.PrepareContext(ctx, "SELECT "+apiKeyColumns+" FROM api_keys WHERE key_hash = $1")
+	if err != nil {
+		return models.ApiKey{}, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	key, err := scanApiKey(stmt.QueryRowContext(ctx, keyHash))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.ApiKey{}, fmt.Errorf("%s: %w", op, ErrApiKeyNotFound)
+		}
+		return models.ApiKey{}, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return key, nil
+}
+
+// ListApiKeys returns keys of the user that have not been revoked.
+func (s *Storage) ListApiKeys(ctx context.Context, userID int64) ([]models.ApiKey, error) {
+	const op = "storage.postgres.ListApiKeys"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"SELECT "+apiKeyColumns+" FROM api_keys WHERE user_id = $1 AND revoked_at IS NULL ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+	defer rows.Close()
+
+	var keys []models.ApiKey
+	for rows.Next() {
+		key, err := scanApiKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan row: %w", op, err)
+		}
+		keys = append(keys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	return keys, nil
+}
+
+func (s *Storage) RevokeApiKey(ctx context.Context, userID, keyID int64) error {
+	const op = "storage.postgres.RevokeApiKey"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE api_keys SET revoked_at = NOW() WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, keyID, userID)
+	if err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: get rows affected: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrApiKeyNotFound)
+	}
+
+	return nil
+}
+
+// TouchApiKey records key usage. The update is skipped if the key was used
+// less than a minute ago to avoid a write on every request.
+func (s *Storage) TouchApiKey(ctx context.Context, keyID int64) error {
+	const op = "storage.postgres.TouchApiKey"
+
+	_, err := s.db.ExecContext(ctx,
+		"UPDATE api_keys SET last_used_at = NOW() WHERE id = $1 "+
+			"AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')", keyID)
+	if err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
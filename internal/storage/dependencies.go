@@ -0,0 +1,171 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"mod1/internal/models"
+)
+
+// dependencyLockClass is the first key of advisory locks serializing
+// changes of task dependencies among tasks of an owner, the second one is
+// the owner ID.
+const dependencyLockClass = 2
+
+// openBlockersQuery selects open blockers of tasks.id; blockers that are
+// completed (3) or cancelled (5) no longer block.
+const openBlockersQuery = "SELECT 1 FROM task_dependencies d JOIN tasks b ON b.id = d.blocked_by_id " +
+	"WHERE d.task_id = tasks.id AND b.status NOT IN (3, 5)"
+
+// AddDependency marks taskID as blocked by blockedByID. The user must be
+// an editor of taskID and see blockedByID; both tasks must have the same
+// owner, and blockedByID must not already depend on taskID, directly or
+// through other tasks. The blocked task gets a new version.
+func (s *Storage) AddDependency(ctx context.Context, userID, taskID, blockedByID int64) error {
+	const op = "storage.postgres.AddDependency"
+
+	if taskID == blockedByID {
+		return fmt.Errorf("%s: %w", op, ErrDependencyCycle)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	// Rows are locked in ID order, so that concurrent calls for the same
+	// pair of tasks do not deadlock.
+	owners := make(map[int64]int64, 2)
+	roles := make(map[int64]models.MemberRole, 2)
+	for _, id := range []int64{min(taskID, blockedByID), max(taskID, blockedByID)} {
+		owners[id], roles[id], err = taskRole(ctx, tx, userID, id, "FOR UPDATE")
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	if roles[taskID] < models.MEMBER_ROLE_EDITOR {
+		return fmt.Errorf("%s: %w", op, ErrAccessDenied)
+	}
+
+	// Зависимости только между задачами одного владельца, чтобы блокировки
+	// по владельцу хватало для проверки циклов.
+	ownerID := owners[taskID]
+	if owners[blockedByID] != ownerID {
+		return fmt.Errorf("%s: %w", op, ErrTaskNotFound)
+	}
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1, $2)", dependencyLockClass, ownerID); err != nil {
+		return fmt.Errorf("%s: lock dependencies: %w", op, err)
+	}
+
+	// Цикл появится, если taskID уже среди блокеров blockedByID.
+	var cycle bool
+	err = tx.QueryRowContext(ctx,
+		"WITH RECURSIVE blockers AS ("+
+			"SELECT blocked_by_id AS id FROM task_dependencies WHERE task_id = $1 "+
+			"UNION "+
+			"SELECT d.blocked_by_id FROM task_dependencies d JOIN blockers b ON d.task_id = b.id"+
+			") SELECT EXISTS(SELECT 1 FROM blockers WHERE id = $2)",
+		blockedByID, taskID).Scan(&cycle)
+	if err != nil {
+		return fmt.Errorf("%s: check cycle: %w", op, err)
+	}
+	if cycle {
+		return fmt.Errorf("%s: %w", op, ErrDependencyCycle)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO task_dependencies (task_id, blocked_by_id) VALUES ($1, $2)", taskID, blockedByID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, ErrDependencyExists)
+		}
+		return fmt.Errorf("%s: insert dependency: %w", op, err)
+	}
+
+	if err := touchTask(ctx, tx, taskID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+// RemoveDependency unblocks taskID from blockedByID. The user must be an
+// editor of taskID. The task gets a new version.
+func (s *Storage) RemoveDependency(ctx context.Context, userID, taskID, blockedByID int64) error {
+	const op = "storage.postgres.RemoveDependency"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	_, role, err := taskRole(ctx, tx, userID, taskID, "FOR UPDATE")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if role < models.MEMBER_ROLE_EDITOR {
+		return fmt.Errorf("%s: %w", op, ErrAccessDenied)
+	}
+
+	res, err := tx.ExecContext(ctx,
+		"DELETE FROM task_dependencies WHERE task_id = $1 AND blocked_by_id = $2",
+		taskID, blockedByID)
+	if err != nil {
+		return fmt.Errorf("%s: delete dependency: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: get rows affected: %w", op, err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrDependencyNotFound)
+	}
+
+	if err := touchTask(ctx, tx, taskID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+// checkTaskUnblocked returns ErrTaskBlocked if the task is moved to status
+// while it has open blockers. Keeping the current status is always allowed.
+func checkTaskUnblocked(ctx context.Context, tx *sql.Tx, userID, taskID int64, status models.TaskStatus) error {
+	var blocked bool
+	err := tx.QueryRowContext(ctx,
+		"SELECT status <> $3 AND EXISTS("+openBlockersQuery+") FROM tasks WHERE id = $1 AND user_id = $2",
+		taskID, userID, int32(status)).Scan(&blocked)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// UpdateTask reports the missing task itself.
+			return nil
+		}
+		return fmt.Errorf("check blockers: %w", err)
+	}
+	if blocked {
+		return ErrTaskBlocked
+	}
+	return nil
+}
+
+// touchTask bumps the version of a task whose dependencies changed.
+func touchTask(ctx context.Context, tx *sql.Tx, taskID int64) error {
+	_, err := tx.ExecContext(ctx,
+		"UPDATE tasks SET version = version + 1, updated_at = NOW() WHERE id = $1", taskID)
+	if err != nil {
+		return fmt.Errorf("touch task: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+func (s *Storage) SaveEmailVerificationToken(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error {
+	const op = "storage.postgres.SaveEmailVerificationToken"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"INSERT INTO email_verification_tokens (user_id, token_hash, expires_at) VALUES ($1, $2, $3)")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err = stmt.ExecContext(ctx, userID, tokenHash, expiresAt); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
+
+// VerifyEmail consumes an unused, unexpired verification token and marks
+// email of its user as verified. Returns the user ID.
+func (s *Storage) VerifyEmail(ctx context.Context, tokenHash string) (int64, error) {
+	const op = "storage.postgres.VerifyEmail"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var userID int64
+	err = tx.QueryRowContext(ctx,
+		"UPDATE email_verification_tokens SET used_at = NOW() "+
+			"WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW() RETURNING user_id",
+		tokenHash).Scan(&userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, fmt.Errorf("%s: %w", op, ErrVerificationNotFound)
+		}
+		return 0, fmt.Errorf("%s: consume token: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE users SET email_verified = TRUE, updated_at = NOW() WHERE id = $1", userID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: update user: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE email_verification_tokens SET used_at = NOW() WHERE user_id = $1 AND used_at IS NULL", userID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalidate tokens: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"mod1/internal/models"
+	"time"
+)
+
+// BeginIdempotentRequest claims the key for a new call. If the key is
+// already taken by an unexpired call, that call is returned with
+// started == false; an expired one is taken over.
+func (s *Storage) BeginIdempotentRequest(ctx context.Context, userID int64, key, method, requestHash string, expiresAt time.Time) (models.IdempotencyKey, bool, error) {
+	const op = "storage.postgres.BeginIdempotentRequest"
+
+	var id int64
+	err := s.db.QueryRowContext(ctx,
+		"INSERT INTO idempotency_keys (user_id, idem_key, method, request_hash, expires_at) VALUES ($1, $2, $3, $4, $5) "+
+			"ON CONFLICT (user_id, idem_key) DO UPDATE SET "+
+			"method = EXCLUDED.method, request_hash = EXCLUDED.request_hash, response = NULL, "+
+			"expires_at = EXCLUDED.expires_at, created_at = NOW() "+
+			"WHERE idempotency_keys.expires_at < NOW() RETURNING id",
+		userID, key, method, requestHash, expiresAt).Scan(&id)
+	if err == nil {
+		return models.IdempotencyKey{Method: method, RequestHash: requestHash}, true, nil
+	}
+	if err != sql.ErrNoRows {
+		return models.IdempotencyKey{}, false, fmt.Errorf("%s: insert key: %w", op, err)
+	}
+
+	var existing models.IdempotencyKey
+	err = s.db.QueryRowContext(ctx,
+		"SELECT method, request_hash, response FROM idempotency_keys WHERE user_id = $1 AND idem_key = $2",
+		userID, key).Scan(&existing.Method, &existing.RequestHash, &existing.Response)
+	if err != nil {
+		return models.IdempotencyKey{}, false, fmt.Errorf("%s: get key: %w", op, err)
+	}
+
+	return existing, false, nil
+}
+
+// CompleteIdempotentRequest stores the response of the call holding the key.
+func (s *Storage) CompleteIdempotentRequest(ctx context.Context, userID int64, key string, response []byte) error {
+	const op = "storage.postgres.CompleteIdempotentRequest"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE idempotency_keys SET response = $1 WHERE user_id = $2 AND idem_key = $3")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, response, userID, key); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
+
+// ReleaseIdempotentRequest frees the key of a failed call so it can be retried.
+func (s *Storage) ReleaseIdempotentRequest(ctx context.Context, userID int64, key string) error {
+	const op = "storage.postgres.ReleaseIdempotentRequest"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"DELETE FROM idempotency_keys WHERE user_id = $1 AND idem_key = $2 AND response IS NULL")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, userID, key); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredIdempotencyKeys removes keys past their replay window.
+func (s *Storage) DeleteExpiredIdempotencyKeys(ctx context.Context) error {
+	const op = "storage.postgres.DeleteExpiredIdempotencyKeys"
+
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM idempotency_keys WHERE expires_at < NOW()"); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// GetLoginLock returns the time key is locked until. Zero time means
+// the key is not locked.
+func (s *Storage) GetLoginLock(ctx context.Context, key string) (time.Time, error) {
+	const op = "storage.postgres.GetLoginLock"
+
+	stmt, err := s.db.PrepareContext(ctx, "SELECT locked_until FROM login_throttle WHERE key = $1")
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	var lockedUntil sql.NullTime
+	err = stmt.QueryRowContext(ctx, key).Scan(&lockedUntil)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return lockedUntil.Time, nil
+}
+
+// RecordLoginFailure increments the failure counter of key and returns it.
+// Failures older than window are forgotten and counting starts over.
+func (s *Storage) RecordLoginFailure(ctx context.Context, key string, window time.Duration) (int, error) {
+	const op = "storage.postgres.RecordLoginFailure"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"INSERT INTO login_throttle (key, failures, updated_at) VALUES ($1, 1, NOW()) "+
+			"ON CONFLICT (key) DO UPDATE SET "+
+			"failures = CASE WHEN login_throttle.updated_at < NOW() - make_interval(secs => $2) "+
+			"THEN 1 ELSE login_throttle.failures + 1 END, "+
+			"updated_at = NOW() "+
+			"RETURNING failures")
+	if err != nil {
+		return 0, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	var failures int
+	if err := stmt.QueryRowContext(ctx, key, window.Seconds()).Scan(&failures); err != nil {
+		return 0, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return failures, nil
+}
+
+func (s *Storage) LockLogin(ctx context.Context, key string, until time.Time) error {
+	const op = "storage.postgres.LockLogin"
+
+	stmt, err := s.db.PrepareContext(ctx, "UPDATE login_throttle SET locked_until = $1 WHERE key = $2")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err = stmt.ExecContext(ctx, until, key); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
+
+// ResetLoginFailures forgets failures of key and lifts its lock.
+func (s *Storage) ResetLoginFailures(ctx context.Context, key string) error {
+	const op = "storage.postgres.ResetLoginFailures"
+
+	stmt, err := s.db.PrepareContext(ctx, "DELETE FROM login_throttle WHERE key = $1")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err = stmt.ExecContext(ctx, key); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,319 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"mod1/internal/models"
+)
+
+// taskAccessCond matches tasks the user (query argument $userArg) can read:
+// own tasks, tasks shared with the user and tasks of projects shared with
+// the user.
+func taskAccessCond(userArg int) string {
+	return fmt.Sprintf("(tasks.user_id = $%[1]d "+
+		"OR tasks.id IN (SELECT task_id FROM task_members WHERE user_id = $%[1]d) "+
+		"OR tasks.project_id IN (SELECT project_id FROM project_members WHERE user_id = $%[1]d))", userArg)
+}
+
+// taskRole returns the owner of the task and the role of the user on it:
+// owners of the task have MEMBER_ROLE_OWNER, members the best role granted
+// on the task or on its project. ErrTaskNotFound is returned if the user
+// has no access at all. lock is the row locking clause, FOR UPDATE for
+// changes and empty for reads.
+func taskRole(ctx context.Context, tx *sql.Tx, userID, taskID int64, lock string) (int64, models.MemberRole, error) {
+	var ownerID int64
+	var role models.MemberRole
+	err := tx.QueryRowContext(ctx, fmt.Sprintf(
+		"SELECT user_id, CASE WHEN user_id = $2 THEN %d ELSE GREATEST("+
+			"COALESCE((SELECT m.role FROM task_members m WHERE m.task_id = tasks.id AND m.user_id = $2), 0), "+
+			"COALESCE((SELECT pm.role FROM project_members pm WHERE pm.project_id = tasks.project_id AND pm.user_id = $2), 0)"+
+			") END FROM tasks WHERE id = $1 %s", models.MEMBER_ROLE_OWNER, lock),
+		taskID, userID).Scan(&ownerID, &role)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, 0, ErrTaskNotFound
+		}
+		return 0, 0, fmt.Errorf("get task role: %w", err)
+	}
+	if role == models.MEMBER_ROLE_UNSPECIFIED {
+		return 0, 0, ErrTaskNotFound
+	}
+	return ownerID, role, nil
+}
+
+// projectAccessCond matches projects the user (query argument $userArg)
+// owns or is a member of.
+func projectAccessCond(userArg int) string {
+	return fmt.Sprintf("(projects.user_id = $%[1]d "+
+		"OR projects.id IN (SELECT project_id FROM project_members WHERE user_id = $%[1]d))", userArg)
+}
+
+// projectRole is taskRole for projects. lock is the row locking clause,
+// FOR UPDATE for changes of the project, FOR SHARE for changes of its
+// tasks and empty for reads.
+func projectRole(ctx context.Context, tx *sql.Tx, userID, projectID int64, lock string) (int64, models.MemberRole, error) {
+	var ownerID int64
+	var role models.MemberRole
+	err := tx.QueryRowContext(ctx, fmt.Sprintf(
+		"SELECT user_id, CASE WHEN user_id = $2 THEN %d ELSE "+
+			"COALESCE((SELECT pm.role FROM project_members pm WHERE pm.project_id = projects.id AND pm.user_id = $2), 0)"+
+			" END FROM projects WHERE id = $1 %s", models.MEMBER_ROLE_OWNER, lock),
+		projectID, userID).Scan(&ownerID, &role)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, 0, ErrProjectNotFound
+		}
+		return 0, 0, fmt.Errorf("get project role: %w", err)
+	}
+	if role == models.MEMBER_ROLE_UNSPECIFIED {
+		return 0, 0, ErrProjectNotFound
+	}
+	return ownerID, role, nil
+}
+
+// membersTable tells where members of tasks or projects are kept.
+type membersTable struct {
+	table  string
+	column string
+}
+
+var (
+	taskMembers    = membersTable{table: "task_members", column: "task_id"}
+	projectMembers = membersTable{table: "project_members", column: "project_id"}
+)
+
+// add shares the object with the user having the email, or changes the
+// role if it is already shared with them.
+func (m membersTable) add(ctx context.Context, tx *sql.Tx, objectID, ownerID int64, email string, role models.MemberRole) (models.Member, error) {
+	member := models.Member{Email: email}
+	err := tx.QueryRowContext(ctx,
+		"SELECT id, username FROM users WHERE email = $1", email).Scan(&member.UserID, &member.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.Member{}, ErrUserNotFound
+		}
+		return models.Member{}, fmt.Errorf("get user: %w", err)
+	}
+	if member.UserID == ownerID {
+		return models.Member{}, ErrMemberIsOwner
+	}
+
+	err = tx.QueryRowContext(ctx,
+		"INSERT INTO "+m.table+" ("+m.column+", user_id, role) VALUES ($1, $2, $3) "+
+			"ON CONFLICT ("+m.column+", user_id) DO UPDATE SET role = EXCLUDED.role RETURNING role, created_at",
+		objectID, member.UserID, int32(role)).Scan(&member.Role, &member.CreatedAt)
+	if err != nil {
+		return models.Member{}, fmt.Errorf("insert member: %w", err)
+	}
+
+	return member, nil
+}
+
+func (m membersTable) remove(ctx context.Context, tx *sql.Tx, objectID, userID int64) error {
+	res, err := tx.ExecContext(ctx,
+		"DELETE FROM "+m.table+" WHERE "+m.column+" = $1 AND user_id = $2", objectID, userID)
+	if err != nil {
+		return fmt.Errorf("delete member: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrMemberNotFound
+	}
+	return nil
+}
+
+func (m membersTable) list(ctx context.Context, tx *sql.Tx, objectID int64) ([]models.Member, error) {
+	rows, err := tx.QueryContext(ctx,
+		"SELECT u.id, u.username, u.email, m.role, m.created_at FROM "+m.table+" m "+
+			"JOIN users u ON u.id = m.user_id WHERE m."+m.column+" = $1 ORDER BY m.role DESC, u.username", objectID)
+	if err != nil {
+		return nil, fmt.Errorf("get members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []models.Member
+	for rows.Next() {
+		var member models.Member
+		if err := rows.Scan(&member.UserID, &member.Username, &member.Email, &member.Role, &member.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan member: %w", err)
+		}
+		members = append(members, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return members, nil
+}
+
+// ShareTask gives the user with the email the role on the task. Only owners
+// of the task may share it.
+func (s *Storage) ShareTask(ctx context.Context, userID, taskID int64, email string, role models.MemberRole) (models.Member, error) {
+	const op = "storage.postgres.ShareTask"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.Member{}, fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	ownerID, callerRole, err := taskRole(ctx, tx, userID, taskID, "FOR UPDATE")
+	if err != nil {
+		return models.Member{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if callerRole < models.MEMBER_ROLE_OWNER {
+		return models.Member{}, fmt.Errorf("%s: %w", op, ErrAccessDenied)
+	}
+
+	member, err := taskMembers.add(ctx, tx, taskID, ownerID, email, role)
+	if err != nil {
+		return models.Member{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Member{}, fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return member, nil
+}
+
+// UnshareTask takes the task away from the member. Owners of the task may
+// remove anyone, other members only themselves.
+func (s *Storage) UnshareTask(ctx context.Context, userID, taskID, memberID int64) error {
+	const op = "storage.postgres.UnshareTask"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	_, callerRole, err := taskRole(ctx, tx, userID, taskID, "FOR UPDATE")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if callerRole < models.MEMBER_ROLE_OWNER && memberID != userID {
+		return fmt.Errorf("%s: %w", op, ErrAccessDenied)
+	}
+
+	if err := taskMembers.remove(ctx, tx, taskID, memberID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListTaskMembers returns users the task is shared with directly, without
+// members of its project.
+func (s *Storage) ListTaskMembers(ctx context.Context, userID, taskID int64) ([]models.Member, error) {
+	const op = "storage.postgres.ListTaskMembers"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if _, _, err := taskRole(ctx, tx, userID, taskID, ""); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	members, err := taskMembers.list(ctx, tx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return members, nil
+}
+
+// ShareProject gives the user with the email the role on the project and
+// all its tasks. Only owners of the project may share it.
+func (s *Storage) ShareProject(ctx context.Context, userID, projectID int64, email string, role models.MemberRole) (models.Member, error) {
+	const op = "storage.postgres.ShareProject"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.Member{}, fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	ownerID, callerRole, err := projectRole(ctx, tx, userID, projectID, "FOR UPDATE")
+	if err != nil {
+		return models.Member{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if callerRole < models.MEMBER_ROLE_OWNER {
+		return models.Member{}, fmt.Errorf("%s: %w", op, ErrAccessDenied)
+	}
+
+	member, err := projectMembers.add(ctx, tx, projectID, ownerID, email, role)
+	if err != nil {
+		return models.Member{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Member{}, fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return member, nil
+}
+
+// UnshareProject takes the project away from the member. Owners of the
+// project may remove anyone, other members only themselves.
+func (s *Storage) UnshareProject(ctx context.Context, userID, projectID, memberID int64) error {
+	const op = "storage.postgres.UnshareProject"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	_, callerRole, err := projectRole(ctx, tx, userID, projectID, "FOR UPDATE")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if callerRole < models.MEMBER_ROLE_OWNER && memberID != userID {
+		return fmt.Errorf("%s: %w", op, ErrAccessDenied)
+	}
+
+	if err := projectMembers.remove(ctx, tx, projectID, memberID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) ListProjectMembers(ctx context.Context, userID, projectID int64) ([]models.Member, error) {
+	const op = "storage.postgres.ListProjectMembers"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if _, _, err := projectRole(ctx, tx, userID, projectID, ""); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	members, err := projectMembers.list(ctx, tx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return members, nil
+}
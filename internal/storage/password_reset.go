@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+func (s *Storage) SavePasswordResetToken(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error {
+	const op = "storage.postgres.SavePasswordResetToken"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"INSERT INTO password_reset_tokens (user_id, token_hash, expires_at) VALUES ($1, $2, $3)")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err = stmt.ExecContext(ctx, userID, tokenHash, expiresAt); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetPasswordResetToken returns the user of an unused, unexpired token
+// without consuming it. Any other token state yields ErrResetTokenNotFound.
+func (s *Storage) GetPasswordResetToken(ctx context.Context, tokenHash string) (int64, error) {
+	const op = "storage.postgres.GetPasswordResetToken"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"SELECT user_id FROM password_reset_tokens "+
+			"WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()")
+	if err != nil {
+		return 0, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	var userID int64
+	err = stmt.QueryRowContext(ctx, tokenHash).Scan(&userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, fmt.Errorf("%s: %w", op, ErrResetTokenNotFound)
+		}
+		return 0, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return userID, nil
+}
+
+// ConsumePasswordResetToken marks an unused, unexpired token as used and
+// returns its user. Any other token state yields ErrResetTokenNotFound.
+func (s *Storage) ConsumePasswordResetToken(ctx context.Context, tokenHash string) (int64, error) {
+	const op = "storage.postgres.ConsumePasswordResetToken"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE password_reset_tokens SET used_at = NOW() "+
+			"WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW() RETURNING user_id")
+	if err != nil {
+		return 0, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	var userID int64
+	err = stmt.QueryRowContext(ctx, tokenHash).Scan(&userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, fmt.Errorf("%s: %w", op, ErrResetTokenNotFound)
+		}
+		return 0, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return userID, nil
+}
+
+// UpdatePassword stores new password hash and invalidates outstanding reset tokens.
+func (s *Storage) UpdatePassword(ctx context.Context, userID int64, passHash []byte) error {
+	const op = "storage.postgres.UpdatePassword"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		"UPDATE users SET password_hash = $1, updated_at = NOW() WHERE id = $2",
+		passHash, userID)
+	if err != nil {
+		return fmt.Errorf("%s: update user: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: get rows affected: %w", op, err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE password_reset_tokens SET used_at = NOW() WHERE user_id = $1 AND used_at IS NULL", userID)
+	if err != nil {
+		return fmt.Errorf("%s: invalidate reset tokens: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
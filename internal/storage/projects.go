@@ -0,0 +1,223 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"mod1/internal/models"
+)
+
+const projectColumns = "id, user_id, name, description, color, archived, created_at, updated_at"
+
+func scanProject(row rowScanner) (models.Project, error) {
+	var project models.Project
+	err := row.Scan(&project.ID, &project.UserID, &project.Name, &project.Description,
+		&project.Color, &project.Archived, &project.CreatedAt, &project.UpdatedAt)
+	return project, err
+}
+
+func (s *Storage) CreateProject(ctx context.Context, userID int64, name, description, color string) (models.Project, error) {
+	const op = "storage.postgres.CreateProject"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"INSERT INTO projects (user_id, name, description, color) VALUES ($1, $2, $3, $4) RETURNING "+projectColumns)
+	if err != nil {
+		return models.Project{}, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	project, err := scanProject(stmt.QueryRowContext(ctx, userID, name, description, color))
+	if err != nil {
+		return models.Project{}, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return project, nil
+}
+
+// GetProject returns the project if the user owns it or is its member.
+func (s *Storage) GetProject(ctx context.Context, userID, projectID int64) (models.Project, error) {
+	const op = "storage.postgres.GetProject"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"SELECT "+projectColumns+" FROM projects WHERE id = $1 AND "+projectAccessCond(2))
+	if err != nil {
+		return models.Project{}, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	project, err := scanProject(stmt.QueryRowContext(ctx, projectID, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.Project{}, fmt.Errorf("%s: %w", op, ErrProjectNotFound)
+		}
+		return models.Project{}, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return project, nil
+}
+
+// ListProjects returns projects the user owns or is a member of.
+func (s *Storage) ListProjects(ctx context.Context, userID int64, includeArchived bool) ([]models.Project, error) {
+	const op = "storage.postgres.ListProjects"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"SELECT "+projectColumns+" FROM projects WHERE "+projectAccessCond(1)+" AND ($2 OR NOT archived) ORDER BY LOWER(name), id")
+	if err != nil {
+		return nil, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, userID, includeArchived)
+	if err != nil {
+		return nil, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+	defer rows.Close()
+
+	var projects []models.Project
+	for rows.Next() {
+		project, err := scanProject(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan row: %w", op, err)
+		}
+		projects = append(projects, project)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	return projects, nil
+}
+
+// UpdateProject changes only the fields set in upd. Members need the
+// editor role.
+func (s *Storage) UpdateProject(ctx context.Context, userID, projectID int64, upd models.ProjectUpdate) (models.Project, error) {
+	const op = "storage.postgres.UpdateProject"
+
+	query := "UPDATE projects SET updated_at = NOW()"
+	var args []interface{}
+	argCount := 1
+
+	if upd.Name != nil {
+		query += fmt.Sprintf(", name = $%d", argCount)
+		args = append(args, *upd.Name)
+		argCount++
+	}
+
+	if upd.Description != nil {
+		query += fmt.Sprintf(", description = $%d", argCount)
+		args = append(args, *upd.Description)
+		argCount++
+	}
+
+	if upd.Color != nil {
+		query += fmt.Sprintf(", color = $%d", argCount)
+		args = append(args, *upd.Color)
+		argCount++
+	}
+
+	if upd.Archived != nil {
+		query += fmt.Sprintf(", archived = $%d", argCount)
+		args = append(args, *upd.Archived)
+		argCount++
+	}
+
+	query += fmt.Sprintf(" WHERE id = $%d RETURNING %s", argCount, projectColumns)
+	args = append(args, projectID)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.Project{}, fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	_, role, err := projectRole(ctx, tx, userID, projectID, "FOR UPDATE")
+	if err != nil {
+		return models.Project{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if role < models.MEMBER_ROLE_EDITOR {
+		return models.Project{}, fmt.Errorf("%s: %w", op, ErrAccessDenied)
+	}
+
+	project, err := scanProject(tx.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		return models.Project{}, fmt.Errorf("%s: update project: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Project{}, fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return project, nil
+}
+
+// DeleteProject deletes the project. Its tasks are kept without a project
+// and get a new version. Members need the owner role.
+func (s *Storage) DeleteProject(ctx context.Context, userID, projectID int64) error {
+	const op = "storage.postgres.DeleteProject"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	_, role, err := projectRole(ctx, tx, userID, projectID, "FOR UPDATE")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if role < models.MEMBER_ROLE_OWNER {
+		return fmt.Errorf("%s: %w", op, ErrAccessDenied)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE tasks SET project_id = NULL, version = version + 1, updated_at = NOW() "+
+			"WHERE project_id = $1", projectID)
+	if err != nil {
+		return fmt.Errorf("%s: detach tasks: %w", op, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM projects WHERE id = $1", projectID); err != nil {
+		return fmt.Errorf("%s: delete project: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+// checkProjectMove decides whether a task may change its project. Only the
+// task owner moves tasks, and only into projects they own, so editors cannot
+// pass a task to their own project and share it further, and a task in
+// a project always belongs to the project owner, as CreateTask makes it.
+func checkProjectMove(role models.MemberRole, taskOwnerID, projectOwnerID int64) error {
+	if role < models.MEMBER_ROLE_OWNER || projectOwnerID != taskOwnerID {
+		return ErrAccessDenied
+	}
+	return nil
+}
+
+// checkTaskProject makes sure the user may put tasks into the project: they
+// own it or are its editor, and it is not archived. The owner of the
+// project is returned.
+func checkTaskProject(ctx context.Context, tx *sql.Tx, userID, projectID int64) (int64, error) {
+	ownerID, role, err := projectRole(ctx, tx, userID, projectID, "FOR SHARE")
+	if err != nil {
+		return 0, err
+	}
+	if role < models.MEMBER_ROLE_EDITOR {
+		return 0, ErrAccessDenied
+	}
+
+	var archived bool
+	err = tx.QueryRowContext(ctx, "SELECT archived FROM projects WHERE id = $1", projectID).Scan(&archived)
+	if err != nil {
+		return 0, fmt.Errorf("check project: %w", err)
+	}
+	if archived {
+		return 0, ErrProjectArchived
+	}
+	return ownerID, nil
+}
@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"errors"
+	"mod1/internal/models"
+	"testing"
+)
+
+func TestCheckProjectMove(t *testing.T) {
+	const (
+		owner = int64(1)
+		other = int64(2)
+	)
+
+	tests := []struct {
+		name           string
+		role           models.MemberRole
+		projectOwnerID int64
+		wantErr        error
+	}{
+		{name: "owner moves into own project", role: models.MEMBER_ROLE_OWNER, projectOwnerID: owner},
+		{name: "editor moves into project of the task owner", role: models.MEMBER_ROLE_EDITOR, projectOwnerID: owner, wantErr: ErrAccessDenied},
+		{name: "editor moves into own project", role: models.MEMBER_ROLE_EDITOR, projectOwnerID: other, wantErr: ErrAccessDenied},
+		{name: "viewer moves task", role: models.MEMBER_ROLE_VIEWER, projectOwnerID: owner, wantErr: ErrAccessDenied},
+		{name: "owner moves into project of another user", role: models.MEMBER_ROLE_OWNER, projectOwnerID: other, wantErr: ErrAccessDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkProjectMove(tt.role, owner, tt.projectOwnerID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkProjectMove() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
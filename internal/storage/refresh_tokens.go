@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"mod1/internal/models"
+	"time"
+)
+
+func (s *Storage) SaveRefreshToken(ctx context.Context, userID, sessionID int64, tokenHash string, expiresAt time.Time) error {
+	const op = "storage.postgres.SaveRefreshToken"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"INSERT INTO refresh_tokens (user_id, session_id, token_hash, expires_at) VALUES ($1, $2, $3, $4)")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err = stmt.ExecContext(ctx, userID, sessionID, tokenHash, expiresAt); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetRefreshToken(ctx context.Context, tokenHash string) (models.RefreshToken, error) {
+	const op = "storage.postgres.GetRefreshToken"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"SELECT id, user_id, session_id, token_hash, expires_at, revoked_at FROM refresh_tokens WHERE token_hash = $1")
+	if err != nil {
+		return models.RefreshToken{}, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	var token models.RefreshToken
+	var sessionID sql.NullInt64
+	var revokedAt sql.NullTime
+	err = stmt.QueryRowContext(ctx, tokenHash).Scan(&token.ID, &token.UserID, &sessionID, &token.TokenHash, &token.ExpiresAt, &revokedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.RefreshToken{}, fmt.Errorf("%s: %w", op, ErrRefreshTokenNotFound)
+		}
+		return models.RefreshToken{}, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	token.SessionID = sessionID.Int64
+	if revokedAt.Valid {
+		token.RevokedAt = &revokedAt.Time
+	}
+
+	return token, nil
+}
+
+// RevokeRefreshToken marks token as used. It returns ErrRefreshTokenNotFound
+// if the token does not exist or has already been revoked, so concurrent
+// refreshes with the same token cannot both succeed.
+func (s *Storage) RevokeRefreshToken(ctx context.Context, tokenID int64) error {
+	const op = "storage.postgres.RevokeRefreshToken"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE refresh_tokens SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, tokenID)
+	if err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: get rows affected: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrRefreshTokenNotFound)
+	}
+
+	return nil
+}
+
+func (s *Storage) RevokeUserRefreshTokens(ctx context.Context, userID int64) error {
+	const op = "storage.postgres.RevokeUserRefreshTokens"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE refresh_tokens SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err = stmt.ExecContext(ctx, userID); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
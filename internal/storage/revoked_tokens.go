@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+func (s *Storage) RevokeToken(ctx context.Context, jti string, userID int64, expiresAt time.Time) error {
+	const op = "storage.postgres.RevokeToken"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"INSERT INTO revoked_tokens (jti, user_id, expires_at) VALUES ($1, $2, $3) ON CONFLICT (jti) DO NOTHING")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err = stmt.ExecContext(ctx, jti, userID, expiresAt); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredRevokedTokens removes revocation entries of tokens
+// that are already expired and would be rejected anyway.
+func (s *Storage) DeleteExpiredRevokedTokens(ctx context.Context) error {
+	const op = "storage.postgres.DeleteExpiredRevokedTokens"
+
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM revoked_tokens WHERE expires_at < NOW()"); err != nil {
+		return fmt.Errorf("%s: delete revoked tokens: %w", op, err)
+	}
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM session_tokens WHERE expires_at < NOW()"); err != nil {
+		return fmt.Errorf("%s: delete session tokens: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetTokenState returns current token generation of the user and whether
+// the token with given jti has been revoked, by itself or by ending the
+// session it was issued for.
+func (s *Storage) GetTokenState(ctx context.Context, userID int64, jti string) (int64, bool, error) {
+	const op = "storage.postgres.GetTokenState"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"SELECT token_generation, "+
+			"EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $2) OR "+
+			"EXISTS (SELECT 1 FROM session_tokens st JOIN sessions ON sessions.id = st.session_id "+
+			"WHERE st.jti = $2 AND sessions.revoked_at IS NOT NULL) "+
+			"FROM users WHERE id = $1")
+	if err != nil {
+		return 0, false, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	var generation int64
+	var revoked bool
+	err = stmt.QueryRowContext(ctx, userID, jti).Scan(&generation, &revoked)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, false, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+		return 0, false, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return generation, revoked, nil
+}
+
+func (s *Storage) IncrementTokenGeneration(ctx context.Context, userID int64) error {
+	const op = "storage.postgres.IncrementTokenGeneration"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE users SET token_generation = token_generation + 1, updated_at = NOW() WHERE id = $1")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: get rows affected: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+	}
+
+	return nil
+}
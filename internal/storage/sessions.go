@@ -0,0 +1,214 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"mod1/internal/models"
+	"time"
+)
+
+func (s *Storage) CreateSession(ctx context.Context, userID int64, userAgent, ip string) (int64, error) {
+	const op = "storage.postgres.CreateSession"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"INSERT INTO sessions (user_id, user_agent, ip) VALUES ($1, $2, $3) RETURNING id")
+	if err != nil {
+		return 0, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	var sessionID int64
+	if err := stmt.QueryRowContext(ctx, userID, userAgent, ip).Scan(&sessionID); err != nil {
+		return 0, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return sessionID, nil
+}
+
+// UpdateSessionToken links the session to a newly issued access token
+// and bumps its last seen time. The token is also recorded in
+// session_tokens until expiresAt, so it is rejected once the session ends
+// even after newer tokens were issued.
+func (s *Storage) UpdateSessionToken(ctx context.Context, sessionID int64, jti string, expiresAt time.Time) error {
+	const op = "storage.postgres.UpdateSessionToken"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE sessions SET access_jti = $1, last_seen_at = NOW() WHERE id = $2", jti, sessionID)
+	if err != nil {
+		return fmt.Errorf("%s: update session: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO session_tokens (jti, session_id, expires_at) VALUES ($1, $2, $3)", jti, sessionID, expiresAt)
+	if err != nil {
+		return fmt.Errorf("%s: record token: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListSessions returns sessions of the user that are not revoked and were
+// seen after activeSince, most recently used first.
+func (s *Storage) ListSessions(ctx context.Context, userID int64, activeSince time.Time) ([]models.Session, error) {
+	const op = "storage.postgres.ListSessions"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"SELECT id, user_id, user_agent, ip, COALESCE(access_jti, ''), created_at, last_seen_at FROM sessions "+
+			"WHERE user_id = $1 AND revoked_at IS NULL AND last_seen_at > $2 ORDER BY last_seen_at DESC")
+	if err != nil {
+		return nil, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, userID, activeSince)
+	if err != nil {
+		return nil, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+	defer rows.Close()
+
+	var sessions []models.Session
+	for rows.Next() {
+		var session models.Session
+		err := rows.Scan(&session.ID, &session.UserID, &session.UserAgent, &session.IP,
+			&session.AccessJTI, &session.CreatedAt, &session.LastSeenAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan row: %w", op, err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	return sessions, nil
+}
+
+// GetSessionByToken returns the active session of the user the access
+// token with jti was issued for.
+func (s *Storage) GetSessionByToken(ctx context.Context, userID int64, jti string) (models.Session, error) {
+	const op = "storage.postgres.GetSessionByToken"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"SELECT id, user_id, user_agent, ip, COALESCE(access_jti, ''), created_at, last_seen_at FROM sessions "+
+			"WHERE user_id = $1 AND access_jti = $2 AND revoked_at IS NULL")
+	if err != nil {
+		return models.Session{}, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	var session models.Session
+	err = stmt.QueryRowContext(ctx, userID, jti).Scan(&session.ID, &session.UserID, &session.UserAgent, &session.IP,
+		&session.AccessJTI, &session.CreatedAt, &session.LastSeenAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.Session{}, fmt.Errorf("%s: %w", op, ErrSessionNotFound)
+		}
+		return models.Session{}, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return session, nil
+}
+
+// RevokeSession ends an active session of the user together with its refresh
+// tokens; GetTokenState rejects every access token issued for it from now on.
+// It returns jti of the last access token issued for the session.
+func (s *Storage) RevokeSession(ctx context.Context, userID, sessionID int64) (string, error) {
+	const op = "storage.postgres.RevokeSession"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var jti sql.NullString
+	err = tx.QueryRowContext(ctx,
+		"UPDATE sessions SET revoked_at = NOW() WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL "+
+			"RETURNING access_jti", sessionID, userID).Scan(&jti)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("%s: %w", op, ErrSessionNotFound)
+		}
+		return "", fmt.Errorf("%s: update session: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE refresh_tokens SET revoked_at = NOW() WHERE session_id = $1 AND revoked_at IS NULL", sessionID)
+	if err != nil {
+		return "", fmt.Errorf("%s: revoke refresh tokens: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return jti.String, nil
+}
+
+// RevokeSessionByToken ends the session the access token was issued for.
+// It does nothing if there is no such session.
+func (s *Storage) RevokeSessionByToken(ctx context.Context, userID int64, jti string) error {
+	const op = "storage.postgres.RevokeSessionByToken"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE sessions SET revoked_at = NOW() WHERE user_id = $1 AND access_jti = $2 AND revoked_at IS NULL")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err = stmt.ExecContext(ctx, userID, jti); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) RevokeUserSessions(ctx context.Context, userID int64) error {
+	const op = "storage.postgres.RevokeUserSessions"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE sessions SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err = stmt.ExecContext(ctx, userID); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
+
+// TouchSession bumps last seen time of the session the access token belongs
+// to. To spare writes on every request, it is updated at most once a minute.
+func (s *Storage) TouchSession(ctx context.Context, userID int64, jti string) error {
+	const op = "storage.postgres.TouchSession"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE sessions SET last_seen_at = NOW() "+
+			"WHERE user_id = $1 AND access_jti = $2 AND last_seen_at < NOW() - INTERVAL '1 minute'")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err = stmt.ExecContext(ctx, userID, jti); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
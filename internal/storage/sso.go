@@ -0,0 +1,133 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"mod1/internal/models"
+	"time"
+)
+
+func (s *Storage) SaveSsoState(ctx context.Context, stateHash, codeVerifier, nonce string, expiresAt time.Time) error {
+	const op = "storage.postgres.SaveSsoState"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"INSERT INTO sso_states (state_hash, code_verifier, nonce, expires_at) VALUES ($1, $2, $3, $4)")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err = stmt.ExecContext(ctx, stateHash, codeVerifier, nonce, expiresAt); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
+
+// ConsumeSsoState marks an unused, unexpired state as used and returns
+// the PKCE verifier and nonce saved with it.
+func (s *Storage) ConsumeSsoState(ctx context.Context, stateHash string) (string, string, error) {
+	const op = "storage.postgres.ConsumeSsoState"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE sso_states SET used_at = NOW() "+
+			"WHERE state_hash = $1 AND used_at IS NULL AND expires_at > NOW() RETURNING code_verifier, nonce")
+	if err != nil {
+		return "", "", fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	var codeVerifier, nonce string
+	err = stmt.QueryRowContext(ctx, stateHash).Scan(&codeVerifier, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", "", fmt.Errorf("%s: %w", op, ErrSsoStateNotFound)
+		}
+		return "", "", fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return codeVerifier, nonce, nil
+}
+
+func (s *Storage) GetUserByIdentity(ctx context.Context, provider, subject string) (models.User, error) {
+	const op = "storage.postgres.GetUserByIdentity"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"SELECT "+userColumns+" FROM users "+
+			"WHERE id = (SELECT user_id FROM user_identities WHERE provider = $1 AND subject = $2)")
+	if err != nil {
+		return models.User{}, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	user, err := scanUser(stmt.QueryRowContext(ctx, provider, subject))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.User{}, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+		return models.User{}, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return user, nil
+}
+
+// LinkIdentity links an external identity to an existing user. Callers link
+// only users whose email is already verified locally.
+func (s *Storage) LinkIdentity(ctx context.Context, userID int64, provider, subject string) error {
+	const op = "storage.postgres.LinkIdentity"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"INSERT INTO user_identities (user_id, provider, subject) VALUES ($1, $2, $3)")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, userID, provider, subject); err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, ErrUserExists)
+		}
+		return fmt.Errorf("%s: insert identity: %w", op, err)
+	}
+
+	return nil
+}
+
+// CreateSsoUser creates a user with verified email and no usable password,
+// linked to the external identity.
+func (s *Storage) CreateSsoUser(ctx context.Context, username, email, provider, subject string) (int64, error) {
+	const op = "storage.postgres.CreateSsoUser"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var userID int64
+	err = tx.QueryRowContext(ctx,
+		"INSERT INTO users (username, email, password_hash, email_verified) VALUES ($1, $2, '', TRUE) RETURNING id",
+		username, email).Scan(&userID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, fmt.Errorf("%s: %w", op, ErrUserExists)
+		}
+		return 0, fmt.Errorf("%s: insert user: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO user_identities (user_id, provider, subject) VALUES ($1, $2, $3)", userID, provider, subject)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, fmt.Errorf("%s: %w", op, ErrUserExists)
+		}
+		return 0, fmt.Errorf("%s: insert identity: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return userID, nil
+}
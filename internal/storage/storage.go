@@ -3,15 +3,17 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"mod1/internal/models"
+	"strings"
 	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 
 	cfg "mod1/config"
 )
@@ -20,6 +22,36 @@ const (
 	migrationPath = "file://migrations"
 )
 
+var (
+	ErrUserNotFound         = errors.New("user not found")
+	ErrUserExists           = errors.New("user already exists")
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+	ErrApiKeyNotFound       = errors.New("api key not found")
+	ErrResetTokenNotFound   = errors.New("password reset token not found")
+	ErrVerificationNotFound = errors.New("email verification token not found")
+	ErrTotpStepUsed         = errors.New("totp code already used")
+	ErrRecoveryCodeNotFound = errors.New("recovery code not found")
+	ErrMfaChallengeNotFound = errors.New("mfa challenge not found")
+	ErrSessionNotFound      = errors.New("session not found")
+	ErrSsoStateNotFound     = errors.New("sso state not found")
+	ErrTaskNotFound         = errors.New("task not found")
+	ErrTaskVersionMismatch  = errors.New("task version mismatch")
+	ErrTagNotFound          = errors.New("tag not found")
+	ErrTagExists            = errors.New("tag already exists")
+	ErrProjectNotFound      = errors.New("project not found")
+	ErrProjectArchived      = errors.New("project is archived")
+	ErrParentNotFound       = errors.New("parent task not found")
+	ErrTaskCycle            = errors.New("task cannot be a subtask of itself")
+	ErrTaskTooDeep          = errors.New("task hierarchy is too deep")
+	ErrDependencyCycle      = errors.New("dependency would create a cycle")
+	ErrDependencyExists     = errors.New("dependency already exists")
+	ErrDependencyNotFound   = errors.New("dependency not found")
+	ErrTaskBlocked          = errors.New("task is blocked by open tasks")
+	ErrAccessDenied         = errors.New("access denied")
+	ErrMemberNotFound       = errors.New("member not found")
+	ErrMemberIsOwner        = errors.New("user is the owner")
+)
+
 type Task struct {
 	ID          int64
 	UserID      int64
@@ -27,9 +59,53 @@ type Task struct {
 	Description string
 	DueDate     *time.Time
 	Status      int32
+	Priority    int32
+	Version     int64
+	Tags        []string
+	ProjectID   int64 // 0 if the task is not in a project
+	ParentID    int64 // 0 for top-level tasks
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+
+	// Direct subtasks: how many there are and how many of them are completed.
+	SubtaskCount          int32
+	CompletedSubtaskCount int32
+	// BlockedBy lists tasks this one depends on, Blocked tells whether any
+	// of them is still open (neither completed nor cancelled).
+	BlockedBy []int64
+	Blocked   bool
+	// Subtasks is filled only when the task is loaded as a tree.
+	Subtasks []*Task
 }
+
+// taskColumns selects a task from tasks along with its tags, completion
+// of its subtasks (status 3 is completed) and its blockers.
+const taskColumns = "id, user_id, title, description, due_date, status, priority, version, created_at, updated_at, project_id, parent_id, " +
+	"ARRAY(SELECT tg.name FROM task_tags tt JOIN tags tg ON tg.id = tt.tag_id " +
+	"WHERE tt.task_id = tasks.id ORDER BY LOWER(tg.name)) AS tags, " +
+	"(SELECT COUNT(*) FROM tasks c WHERE c.parent_id = tasks.id) AS subtask_count, " +
+	"(SELECT COUNT(*) FROM tasks c WHERE c.parent_id = tasks.id AND c.status = 3) AS completed_subtask_count, " +
+	"ARRAY(SELECT d.blocked_by_id FROM task_dependencies d WHERE d.task_id = tasks.id ORDER BY d.blocked_by_id) AS blocked_by, " +
+	"EXISTS(" + openBlockersQuery + ") AS blocked"
+
+func scanTask(row rowScanner) (*Task, error) {
+	task := &Task{}
+	var dueDate sql.NullTime
+	var projectID, parentID sql.NullInt64
+	err := row.Scan(&task.ID, &task.UserID, &task.Title, &task.Description, &dueDate,
+		&task.Status, &task.Priority, &task.Version, &task.CreatedAt, &task.UpdatedAt, &projectID, &parentID,
+		pq.Array(&task.Tags), &task.SubtaskCount, &task.CompletedSubtaskCount, pq.Array(&task.BlockedBy), &task.Blocked)
+	if err != nil {
+		return nil, err
+	}
+	task.ProjectID = projectID.Int64
+	task.ParentID = parentID.Int64
+	if dueDate.Valid {
+		task.DueDate = &dueDate.Time
+	}
+	return task, nil
+}
+
 type Storage struct {
 	db *sql.DB
 }
@@ -95,6 +171,9 @@ func (s *Storage) Register(ctx context.Context, username, email string, passHash
 	var userID int64
 	err = stmt.QueryRowContext(ctx, username, email, passHash).Scan(&userID)
 	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, fmt.Errorf("%s: %w", op, ErrUserExists)
+		}
 		return 0, fmt.Errorf("%s: execute statement: %w", op, err)
 	}
 
@@ -104,118 +183,239 @@ func (s *Storage) Register(ctx context.Context, username, email string, passHash
 func (s *Storage) GetUserByUsername(ctx context.Context, email string) (models.User, error) {
 	const op = "storage.postgres.GetUserByUsername"
 
-	stmt, err := s.db.PrepareContext(ctx, "SELECT id, password_hash, username FROM users WHERE email = $1")
+	stmt, err := s.db.PrepareContext(ctx, "SELECT "+userColumns+" FROM users WHERE email = $1")
 	if err != nil {
 		return models.User{}, fmt.Errorf("%s: prepare statement: %w", op, err)
 	}
 	defer stmt.Close()
 
-	var userID int64
-	var passwordHash []byte
-	username := ""
-	err = stmt.QueryRowContext(ctx, email).Scan(&userID, &passwordHash, &username)
+	user, err := scanUser(stmt.QueryRowContext(ctx, email))
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return models.User{}, fmt.Errorf("%s: user not found", op)
+			return models.User{}, fmt.Errorf("%s: %w", op, ErrUserNotFound)
 		}
 		return models.User{}, fmt.Errorf("%s: execute statement: %w", op, err)
 	}
 
-	return models.User{
-		ID:       userID,
-		Username: username,
-		Email:    email,
-		PassHash: passwordHash,
-	}, nil
+	return user, nil
 }
 
-func (s *Storage) CreateTask(ctx context.Context, userID int64, title, description string, dueDate string, status int32) (int64, error) {
-	const op = "storage.postgres.CreateTask"
+func (s *Storage) GetUserByID(ctx context.Context, userID int64) (models.User, error) {
+	const op = "storage.postgres.GetUserByID"
 
-	var parsedDueDate time.Time
-	var err error
-	if dueDate != "" {
-		parsedDueDate, err = time.Parse(time.RFC3339, dueDate)
-		if err != nil {
-			return 0, fmt.Errorf("%s: invalid due date format: %w", op, err)
+	stmt, err := s.db.PrepareContext(ctx, "SELECT "+userColumns+" FROM users WHERE id = $1")
+	if err != nil {
+		return models.User{}, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	user, err := scanUser(stmt.QueryRowContext(ctx, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.User{}, fmt.Errorf("%s: %w", op, ErrUserNotFound)
 		}
+		return models.User{}, fmt.Errorf("%s: execute statement: %w", op, err)
 	}
-	var nullableDueDate sql.NullTime
-	if dueDate != "" {
-		nullableDueDate.Time = parsedDueDate
-		nullableDueDate.Valid = true
-	} else {
-		nullableDueDate.Valid = false
+
+	return user, nil
+}
+
+// CreateTask creates the task with given tags, creating tags the owner does
+// not have yet. A subtask may be at most maxDepth levels deep. Tasks created
+// in a shared project belong to the project owner, like the rest of it.
+func (s *Storage) CreateTask(ctx context.Context, userID int64, t models.NewTask, maxDepth int) (int64, error) {
+	const op = "storage.postgres.CreateTask"
+
+	var dueDate sql.NullTime
+	if t.DueDate != nil {
+		dueDate = sql.NullTime{Time: *t.DueDate, Valid: true}
 	}
 
-	stmt, err := s.db.PrepareContext(ctx,
-		"INSERT INTO tasks (user_id, title, description, due_date, status) VALUES ($1, $2, $3, $4, $5) RETURNING id")
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return 0, fmt.Errorf("%s: prepare statement: %w", op, err)
+		return 0, fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	ownerID := userID
+	if t.ProjectID != 0 {
+		ownerID, err = checkTaskProject(ctx, tx, userID, t.ProjectID)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	if t.ParentID != 0 {
+		if ownerID != userID {
+			// Родитель принадлежит владельцу проекта, нужен доступ к нему.
+			_, role, err := taskRole(ctx, tx, userID, t.ParentID, "FOR UPDATE")
+			if err != nil && err != ErrTaskNotFound {
+				return 0, fmt.Errorf("%s: %w", op, err)
+			}
+			if err == ErrTaskNotFound || role < models.MEMBER_ROLE_EDITOR {
+				return 0, fmt.Errorf("%s: %w", op, ErrParentNotFound)
+			}
+		}
+		if err := checkTaskParent(ctx, tx, ownerID, 0, t.ParentID, maxDepth); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
 	}
-	defer stmt.Close()
 
 	var taskID int64
-	err = stmt.QueryRowContext(ctx, userID, title, description, nullableDueDate, status).Scan(&taskID)
+	err = tx.QueryRowContext(ctx,
+		"INSERT INTO tasks (user_id, title, description, due_date, status, priority, project_id, parent_id) "+
+			"VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id",
+		ownerID, t.Title, t.Description, dueDate, int32(t.Status), int32(t.Priority),
+		nullID(t.ProjectID), nullID(t.ParentID)).Scan(&taskID)
 	if err != nil {
-		return 0, fmt.Errorf("%s: execute statement: %w", op, err)
+		return 0, fmt.Errorf("%s: insert task: %w", op, err)
+	}
+
+	if err := setTaskTags(ctx, tx, ownerID, taskID, t.Tags); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: commit transaction: %w", op, err)
 	}
 
 	return taskID, nil
 }
 
+// GetTask returns the task if the user owns it or it is shared with them.
 func (s *Storage) GetTask(ctx context.Context, userID, taskID int64) (*Task, error) {
 	const op = "storage.postgres.GetTask"
 
 	stmt, err := s.db.PrepareContext(ctx,
-		"SELECT id, user_id, title, description, due_date, status, created_at, updated_at FROM tasks WHERE id = $1 AND user_id = $2")
+		"SELECT "+taskColumns+" FROM tasks WHERE id = $1 AND "+taskAccessCond(2))
 	if err != nil {
 		return nil, fmt.Errorf("%s: prepare statement: %w", op, err)
 	}
 	defer stmt.Close()
 
-	task := &Task{}
-	var dueDate sql.NullTime
-	err = stmt.QueryRowContext(ctx, taskID, userID).Scan(
-		&task.ID, &task.UserID, &task.Title, &task.Description, &dueDate, &task.Status, &task.CreatedAt, &task.UpdatedAt)
+	task, err := scanTask(stmt.QueryRowContext(ctx, taskID, userID))
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("%s: task not found", op)
+			return nil, fmt.Errorf("%s: %w", op, ErrTaskNotFound)
 		}
 		return nil, fmt.Errorf("%s: execute statement: %w", op, err)
 	}
 
-	if dueDate.Valid {
-		task.DueDate = &dueDate.Time
-	}
-
 	return task, nil
 }
 
-func (s *Storage) UpdateTask(ctx context.Context, taskID, userID int64, title, description string, dueDate string, status int32) error {
+// UpdateTask changes only the fields set in upd and bumps the task version.
+// If expectedVersion is not zero and differs from the stored one,
+// ErrTaskVersionMismatch is returned. A new parent must keep the subtree
+// of the task within maxDepth levels. Changing a shared task needs the
+// editor role; moving it to another project needs the owner role and
+// a project of the same owner (see checkProjectMove), while parents and
+// tags are those of the task owner.
+func (s *Storage) UpdateTask(ctx context.Context, taskID, userID int64, upd models.TaskUpdate, expectedVersion int64, maxDepth int) error {
 	const op = "storage.postgres.UpdateTask"
 
-	var parsedDueDate sql.NullTime
-	if dueDate != "" {
-		t, err := time.Parse(time.RFC3339, dueDate)
-		if err != nil {
-			return fmt.Errorf("%s: invalid due date format: %w", op, err)
+	query := "UPDATE tasks SET updated_at = NOW(), version = version + 1"
+	var args []interface{}
+	argCount := 1
+
+	if upd.Title != nil {
+		query += fmt.Sprintf(", title = $%d", argCount)
+		args = append(args, *upd.Title)
+		argCount++
+	}
+
+	if upd.Description != nil {
+		query += fmt.Sprintf(", description = $%d", argCount)
+		args = append(args, *upd.Description)
+		argCount++
+	}
+
+	if upd.Status != nil {
+		query += fmt.Sprintf(", status = $%d", argCount)
+		args = append(args, int32(*upd.Status))
+		argCount++
+	}
+
+	if upd.Priority != nil {
+		query += fmt.Sprintf(", priority = $%d", argCount)
+		args = append(args, int32(*upd.Priority))
+		argCount++
+	}
+
+	if upd.UpdateProject {
+		query += fmt.Sprintf(", project_id = $%d", argCount)
+		args = append(args, nullID(upd.ProjectID))
+		argCount++
+	}
+
+	if upd.UpdateParent {
+		query += fmt.Sprintf(", parent_id = $%d", argCount)
+		args = append(args, nullID(upd.ParentID))
+		argCount++
+	}
+
+	if upd.UpdateDueDate {
+		var dueDate sql.NullTime
+		if upd.DueDate != nil {
+			dueDate = sql.NullTime{Time: *upd.DueDate, Valid: true}
 		}
-		parsedDueDate = sql.NullTime{Time: t, Valid: true}
-	} else {
-		parsedDueDate = sql.NullTime{Valid: false}
+		query += fmt.Sprintf(", due_date = $%d", argCount)
+		args = append(args, dueDate)
+		argCount++
 	}
 
-	stmt, err := s.db.PrepareContext(ctx,
-		"UPDATE tasks SET title = $1, description = $2, due_date = $3, status = $4, updated_at = NOW() WHERE id = $5 AND user_id = $6")
+	query += fmt.Sprintf(" WHERE id = $%d AND (version = $%d OR $%d = 0)",
+		argCount, argCount+1, argCount+1)
+	args = append(args, taskID, expectedVersion)
+
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("%s: prepare statement: %w", op, err)
+		return fmt.Errorf("%s: begin transaction: %w", op, err)
 	}
-	defer stmt.Close()
+	defer tx.Rollback()
+
+	ownerID, role, err := taskRole(ctx, tx, userID, taskID, "FOR UPDATE")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if role < models.MEMBER_ROLE_EDITOR {
+		return fmt.Errorf("%s: %w", op, ErrAccessDenied)
+	}
+
+	if upd.UpdateProject {
+		var currentProject sql.NullInt64
+		err := tx.QueryRowContext(ctx, "SELECT project_id FROM tasks WHERE id = $1", taskID).Scan(&currentProject)
+		if err != nil {
+			return fmt.Errorf("%s: get task project: %w", op, err)
+		}
 
-	res, err := stmt.ExecContext(ctx, title, description, parsedDueDate, status, taskID, userID)
+		// Clients sending the whole task repeat its project; that is not a move.
+		if currentProject.Int64 != upd.ProjectID {
+			projectOwnerID := ownerID
+			if upd.ProjectID != 0 {
+				projectOwnerID, err = checkTaskProject(ctx, tx, userID, upd.ProjectID)
+				if err != nil {
+					return fmt.Errorf("%s: %w", op, err)
+				}
+			}
+			if err := checkProjectMove(role, ownerID, projectOwnerID); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+	if upd.UpdateParent && upd.ParentID != 0 {
+		if err := checkTaskParent(ctx, tx, ownerID, taskID, upd.ParentID, maxDepth); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	if upd.RequireUnblocked && upd.Status != nil {
+		if err := checkTaskUnblocked(ctx, tx, ownerID, taskID, *upd.Status); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	res, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("%s: execute statement: %w", op, err)
+		return fmt.Errorf("%s: update task: %w", op, err)
 	}
 
 	rowsAffected, err := res.RowsAffected()
@@ -223,74 +423,170 @@ func (s *Storage) UpdateTask(ctx context.Context, taskID, userID int64, title, d
 		return fmt.Errorf("%s: get rows affected: %w", op, err)
 	}
 
+	// The task is locked, so only its version could stop the update.
 	if rowsAffected == 0 {
-		return fmt.Errorf("%s: task not found or unauthorized", op)
+		return fmt.Errorf("%s: %w", op, ErrTaskVersionMismatch)
+	}
+
+	if upd.UpdateTags {
+		if err := setTaskTags(ctx, tx, ownerID, taskID, upd.Tags); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
 	}
 
 	return nil
 }
 
-func (s *Storage) DeleteTask(ctx context.Context, taskID, userID int64) error {
+// nullID stores 0 of optional references as NULL.
+func nullID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id != 0}
+}
+
+// taskOrderColumns maps sort keys of ListTasks to columns.
+var taskOrderColumns = map[string]string{
+	models.TaskOrderPriority:  "priority",
+	models.TaskOrderDueDate:   "due_date",
+	models.TaskOrderCreatedAt: "created_at",
+	models.TaskOrderUpdatedAt: "updated_at",
+}
+
+// taskOrderBy builds the ORDER BY clause. id is always the last key so that
+// pages do not overlap when other keys are equal.
+func taskOrderBy(order []models.TaskOrder) (string, error) {
+	keys := make([]string, 0, len(order)+1)
+	for _, o := range order {
+		column, ok := taskOrderColumns[o.Field]
+		if !ok {
+			return "", fmt.Errorf("unknown sort key %q", o.Field)
+		}
+		if o.Desc {
+			column += " DESC"
+		}
+		// Tasks without a due date go last in both directions.
+		keys = append(keys, column+" NULLS LAST")
+	}
+
+	return strings.Join(append(keys, "id"), ", "), nil
+}
+
+// DeleteTask deletes the task if expectedVersion is zero or matches.
+// Subtasks are deleted with it unless reparent is set, then they are moved
+// to the parent of the deleted task. Deleting a shared task needs the owner
+// role.
+func (s *Storage) DeleteTask(ctx context.Context, taskID, userID, expectedVersion int64, reparent bool) error {
 	const op = "storage.postgres.DeleteTask"
 
-	stmt, err := s.db.PrepareContext(ctx, "DELETE FROM tasks WHERE id = $1 AND user_id = $2")
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("%s: prepare statement: %w", op, err)
+		return fmt.Errorf("%s: begin transaction: %w", op, err)
 	}
-	defer stmt.Close()
+	defer tx.Rollback()
 
-	res, err := stmt.ExecContext(ctx, taskID, userID)
+	_, role, err := taskRole(ctx, tx, userID, taskID, "FOR UPDATE")
 	if err != nil {
-		return fmt.Errorf("%s: execute statement: %w", op, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if role < models.MEMBER_ROLE_OWNER {
+		return fmt.Errorf("%s: %w", op, ErrAccessDenied)
 	}
 
-	rowsAffected, err := res.RowsAffected()
+	var parentID sql.NullInt64
+	err = tx.QueryRowContext(ctx,
+		"SELECT parent_id FROM tasks WHERE id = $1 AND (version = $2 OR $2 = 0)",
+		taskID, expectedVersion).Scan(&parentID)
 	if err != nil {
-		return fmt.Errorf("%s: get rows affected: %w", op, err)
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("%s: %w", op, ErrTaskVersionMismatch)
+		}
+		return fmt.Errorf("%s: get task: %w", op, err)
 	}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("%s: task not found or unauthorized", op)
+	// Иначе подзадачи удалит ON DELETE CASCADE.
+	if reparent {
+		_, err = tx.ExecContext(ctx,
+			"UPDATE tasks SET parent_id = $1, version = version + 1, updated_at = NOW() WHERE parent_id = $2",
+			parentID, taskID)
+		if err != nil {
+			return fmt.Errorf("%s: reparent subtasks: %w", op, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM tasks WHERE id = $1", taskID); err != nil {
+		return fmt.Errorf("%s: delete task: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
 	}
 
 	return nil
 }
 
-func (s *Storage) ListTasks(ctx context.Context, userID int64, status *int32, dueDateFrom, dueDateTo *string, pageSize, pageToken int32) ([]*Task, error) {
+// ListTasks returns a page of tasks the user owns or is a member of
+// (only owns with filter.OwnedOnly) matching filter.
+func (s *Storage) ListTasks(ctx context.Context, userID int64, filter models.TaskFilter, pageSize, pageToken int32) ([]*Task, error) {
 	const op = "storage.postgres.ListTasks"
 
-	query := "SELECT id, user_id, title, description, due_date, status, created_at, updated_at FROM tasks WHERE user_id = $1"
+	query := "SELECT " + taskColumns + " FROM tasks WHERE " + taskAccessCond(1)
+	if filter.OwnedOnly {
+		query = "SELECT " + taskColumns + " FROM tasks WHERE user_id = $1"
+	}
 	var args []interface{}
 	args = append(args, userID)
 	argCount := 2
 
-	if status != nil {
+	if filter.Status != nil {
 		query += fmt.Sprintf(" AND status = $%d", argCount)
-		args = append(args, *status)
+		args = append(args, int32(*filter.Status))
 		argCount++
 	}
 
-	if dueDateFrom != nil {
+	if filter.Priority != nil {
+		query += fmt.Sprintf(" AND priority = $%d", argCount)
+		args = append(args, int32(*filter.Priority))
+		argCount++
+	}
+
+	if filter.ProjectID != nil {
+		query += fmt.Sprintf(" AND project_id = $%d", argCount)
+		args = append(args, *filter.ProjectID)
+		argCount++
+	}
+
+	if filter.ParentID != nil {
+		query += fmt.Sprintf(" AND parent_id = $%d", argCount)
+		args = append(args, *filter.ParentID)
+		argCount++
+	}
+
+	if filter.TopLevel {
+		query += " AND parent_id IS NULL"
+	}
+
+	if filter.DueDateFrom != nil {
 		query += fmt.Sprintf(" AND due_date >= $%d", argCount)
-		t, err := time.Parse(time.RFC3339, *dueDateFrom)
-		if err != nil {
-			return nil, fmt.Errorf("%s: invalid due date from format: %w", op, err)
-		}
-		args = append(args, t)
+		args = append(args, *filter.DueDateFrom)
 		argCount++
 	}
 
-	if dueDateTo != nil {
+	if filter.DueDateTo != nil {
 		query += fmt.Sprintf(" AND due_date <= $%d", argCount)
-
-		t, err := time.Parse(time.RFC3339, *dueDateTo)
-		if err != nil {
-			return nil, fmt.Errorf("%s: invalid due date to format: %w", op, err)
-		}
-		args = append(args, t)
+		args = append(args, *filter.DueDateTo)
 		argCount++
 	}
 
+	query, args, argCount = appendTagFilter(query, args, argCount, filter.Tags)
+
+	orderBy, err := taskOrderBy(filter.OrderBy)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	query += " ORDER BY " + orderBy
+
 	query += " LIMIT $" + fmt.Sprint(argCount) + " OFFSET $" + fmt.Sprint(argCount+1)
 	args = append(args, pageSize)
 	args = append(args, pageSize*pageToken)
@@ -309,17 +605,10 @@ func (s *Storage) ListTasks(ctx context.Context, userID int64, status *int32, du
 
 	var tasks []*Task
 	for rows.Next() {
-		task := &Task{}
-		var dueDate sql.NullTime
-		err := rows.Scan(
-			&task.ID, &task.UserID, &task.Title, &task.Description, &dueDate, &task.Status, &task.CreatedAt, &task.UpdatedAt)
-
+		task, err := scanTask(rows)
 		if err != nil {
 			return nil, fmt.Errorf("%s: scan row: %w", op, err)
 		}
-		if dueDate.Valid {
-			task.DueDate = &dueDate.Time
-		}
 
 		tasks = append(tasks, task)
 	}
@@ -331,21 +620,28 @@ func (s *Storage) ListTasks(ctx context.Context, userID int64, status *int32, du
 	return tasks, nil
 }
 
-func (s *Storage) SearchTasks(ctx context.Context, userID int64, query string, pageSize, pageToken int32) ([]*Task, error) {
+func (s *Storage) SearchTasks(ctx context.Context, userID int64, query string, tags models.TagFilter, pageSize, pageToken int32) ([]*Task, error) {
 	const op = "storage.postgres.SearchTasks"
 
-	stmt, err := s.db.PrepareContext(ctx,
-		"SELECT id, user_id, title, description, due_date, status, created_at, updated_at FROM tasks "+
-			"WHERE user_id = $1 AND (title ILIKE $2 OR description ILIKE $2) "+
-			"LIMIT $3 OFFSET $4")
+	searchQuery := "%" + query + "%"
+
+	sqlQuery := "SELECT " + taskColumns + " FROM tasks " +
+		"WHERE " + taskAccessCond(1) + " AND (title ILIKE $2 OR description ILIKE $2)"
+	args := []interface{}{userID, searchQuery}
+	argCount := 3
+
+	sqlQuery, args, argCount = appendTagFilter(sqlQuery, args, argCount, tags)
+
+	sqlQuery += fmt.Sprintf(" ORDER BY id LIMIT $%d OFFSET $%d", argCount, argCount+1)
+	args = append(args, pageSize, pageSize*pageToken)
+
+	stmt, err := s.db.PrepareContext(ctx, sqlQuery)
 	if err != nil {
 		return nil, fmt.Errorf("%s: prepare statement: %w", op, err)
 	}
 	defer stmt.Close()
 
-	searchQuery := "%" + query + "%"
-
-	rows, err := stmt.QueryContext(ctx, userID, searchQuery, pageSize, pageSize*pageToken)
+	rows, err := stmt.QueryContext(ctx, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: execute statement: %w", op, err)
 	}
@@ -353,17 +649,10 @@ func (s *Storage) SearchTasks(ctx context.Context, userID int64, query string, p
 
 	var tasks []*Task
 	for rows.Next() {
-		task := &Task{}
-		var dueDate sql.NullTime
-
-		err := rows.Scan(&task.ID, &task.UserID, &task.Title, &task.Description, &dueDate, &task.Status, &task.CreatedAt, &task.UpdatedAt)
-
+		task, err := scanTask(rows)
 		if err != nil {
 			return nil, fmt.Errorf("%s: scan row: %w", op, err)
 		}
-		if dueDate.Valid {
-			task.DueDate = &dueDate.Time
-		}
 
 		tasks = append(tasks, task)
 	}
@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// hierarchyLockClass is the first key of advisory locks serializing changes
+// of task hierarchy of a user, the second one is the user ID.
+const hierarchyLockClass = 1
+
+// checkTaskParent makes sure parentID can become the parent of taskID
+// (0 for a new task): the parent belongs to the user, is not the task or
+// one of its subtasks, and the subtree of the task stays within maxDepth
+// levels. The hierarchy of the user stays locked until tx ends.
+func checkTaskParent(ctx context.Context, tx *sql.Tx, userID, taskID, parentID int64, maxDepth int) error {
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1, $2)", hierarchyLockClass, userID); err != nil {
+		return fmt.Errorf("lock task hierarchy: %w", err)
+	}
+
+	// Levels above the new position of the task, counting the parent.
+	// The recursion is bounded in case the data has a cycle after all.
+	var parentDepth int
+	var cycle bool
+	err := tx.QueryRowContext(ctx,
+		"WITH RECURSIVE ancestors AS ("+
+			"SELECT id, parent_id, 1 AS depth FROM tasks WHERE id = $1 AND user_id = $2 "+
+			"UNION ALL "+
+			"SELECT t.id, t.parent_id, a.depth + 1 FROM tasks t JOIN ancestors a ON t.id = a.parent_id WHERE a.depth <= $4"+
+			") SELECT COUNT(*), COALESCE(BOOL_OR(id = $3), FALSE) FROM ancestors",
+		parentID, userID, taskID, maxDepth).Scan(&parentDepth, &cycle)
+	if err != nil {
+		return fmt.Errorf("get ancestors: %w", err)
+	}
+	if parentDepth == 0 {
+		return ErrParentNotFound
+	}
+	if cycle {
+		return ErrTaskCycle
+	}
+
+	height := 1
+	if taskID != 0 {
+		err := tx.QueryRowContext(ctx,
+			"WITH RECURSIVE subtree AS ("+
+				"SELECT id, 1 AS depth FROM tasks WHERE id = $1 "+
+				"UNION ALL "+
+				"SELECT t.id, s.depth + 1 FROM tasks t JOIN subtree s ON t.parent_id = s.id WHERE s.depth <= $2"+
+				") SELECT COALESCE(MAX(depth), 1) FROM subtree",
+			taskID, maxDepth).Scan(&height)
+		if err != nil {
+			return fmt.Errorf("get subtree height: %w", err)
+		}
+	}
+
+	if parentDepth+height > maxDepth {
+		return ErrTaskTooDeep
+	}
+
+	return nil
+}
+
+// GetTaskTree returns the task followed by all its subtasks at any depth
+// that the user can read.
+func (s *Storage) GetTaskTree(ctx context.Context, userID, taskID int64) ([]*Task, error) {
+	const op = "storage.postgres.GetTaskTree"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"WITH RECURSIVE subtree AS ("+
+			"SELECT id FROM tasks WHERE id = $1 "+
+			"UNION ALL "+
+			"SELECT t.id FROM tasks t JOIN subtree s ON t.parent_id = s.id"+
+			") SELECT "+taskColumns+" FROM tasks WHERE id IN (SELECT id FROM subtree) AND "+taskAccessCond(2)+" "+
+			"ORDER BY (id = $1) DESC, id")
+	if err != nil {
+		return nil, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, taskID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+	defer rows.Close()
+
+	var tasks []*Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan row: %w", op, err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	if len(tasks) == 0 || tasks[0].ID != taskID {
+		return nil, fmt.Errorf("%s: %w", op, ErrTaskNotFound)
+	}
+
+	return tasks, nil
+}
@@ -0,0 +1,227 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"mod1/internal/models"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+const tagColumns = "id, user_id, name, created_at"
+
+func scanTag(row rowScanner) (models.Tag, error) {
+	var tag models.Tag
+	err := row.Scan(&tag.ID, &tag.UserID, &tag.Name, &tag.CreatedAt)
+	return tag, err
+}
+
+func (s *Storage) CreateTag(ctx context.Context, userID int64, name string) (models.Tag, error) {
+	const op = "storage.postgres.CreateTag"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"INSERT INTO tags (user_id, name) VALUES ($1, $2) RETURNING "+tagColumns)
+	if err != nil {
+		return models.Tag{}, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	tag, err := scanTag(stmt.QueryRowContext(ctx, userID, name))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return models.Tag{}, fmt.Errorf("%s: %w", op, ErrTagExists)
+		}
+		return models.Tag{}, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return tag, nil
+}
+
+func (s *Storage) ListTags(ctx context.Context, userID int64) ([]models.Tag, error) {
+	const op = "storage.postgres.ListTags"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"SELECT "+tagColumns+" FROM tags WHERE user_id = $1 ORDER BY LOWER(name)")
+	if err != nil {
+		return nil, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+	defer rows.Close()
+
+	var tags []models.Tag
+	for rows.Next() {
+		tag, err := scanTag(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan row: %w", op, err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	return tags, nil
+}
+
+// RenameTag changes the name of the tag. Tasks carrying it get a new version,
+// since their tag list changes.
+func (s *Storage) RenameTag(ctx context.Context, userID, tagID int64, name string) (models.Tag, error) {
+	const op = "storage.postgres.RenameTag"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.Tag{}, fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	tag, err := scanTag(tx.QueryRowContext(ctx,
+		"UPDATE tags SET name = $1 WHERE id = $2 AND user_id = $3 RETURNING "+tagColumns,
+		name, tagID, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.Tag{}, fmt.Errorf("%s: %w", op, ErrTagNotFound)
+		}
+		if isUniqueViolation(err) {
+			return models.Tag{}, fmt.Errorf("%s: %w", op, ErrTagExists)
+		}
+		return models.Tag{}, fmt.Errorf("%s: update tag: %w", op, err)
+	}
+
+	if err := touchTaggedTasks(ctx, tx, tagID); err != nil {
+		return models.Tag{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Tag{}, fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return tag, nil
+}
+
+// DeleteTag deletes the tag and removes it from all tasks.
+func (s *Storage) DeleteTag(ctx context.Context, userID, tagID int64) error {
+	const op = "storage.postgres.DeleteTag"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err := touchTaggedTasks(ctx, tx, tagID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM tags WHERE id = $1 AND user_id = $2", tagID, userID)
+	if err != nil {
+		return fmt.Errorf("%s: delete tag: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: get rows affected: %w", op, err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrTagNotFound)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+// touchTaggedTasks bumps version of the tasks carrying the tag.
+func touchTaggedTasks(ctx context.Context, tx *sql.Tx, tagID int64) error {
+	_, err := tx.ExecContext(ctx,
+		"UPDATE tasks SET version = version + 1, updated_at = NOW() "+
+			"WHERE id IN (SELECT task_id FROM task_tags WHERE tag_id = $1)", tagID)
+	if err != nil {
+		return fmt.Errorf("update tagged tasks: %w", err)
+	}
+	return nil
+}
+
+// setTaskTags replaces tags of the task, creating tags the user does not
+// have yet. names must not contain case-insensitive duplicates.
+func setTaskTags(ctx context.Context, tx *sql.Tx, userID, taskID int64, names []string) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM task_tags WHERE task_id = $1", taskID); err != nil {
+		return fmt.Errorf("delete task tags: %w", err)
+	}
+
+	if len(names) == 0 {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx,
+		"INSERT INTO tags (user_id, name) SELECT $1, UNNEST($2::text[]) "+
+			"ON CONFLICT (user_id, (LOWER(name))) DO NOTHING",
+		userID, pq.Array(names))
+	if err != nil {
+		return fmt.Errorf("create tags: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO task_tags (task_id, tag_id) "+
+			"SELECT $1, id FROM tags WHERE user_id = $2 AND LOWER(name) = ANY($3)",
+		taskID, userID, pq.Array(lowerAll(names)))
+	if err != nil {
+		return fmt.Errorf("add task tags: %w", err)
+	}
+
+	return nil
+}
+
+// appendTagFilter adds conditions of f to a query over tasks, numbering
+// its parameters from argCount.
+func appendTagFilter(query string, args []interface{}, argCount int, f models.TagFilter) (string, []interface{}, int) {
+	const hasTag = "SELECT 1 FROM task_tags tt JOIN tags tg ON tg.id = tt.tag_id " +
+		"WHERE tt.task_id = tasks.id AND LOWER(tg.name) = ANY($%d)"
+
+	if len(f.AnyOf) > 0 {
+		query += fmt.Sprintf(" AND EXISTS ("+hasTag+")", argCount)
+		args = append(args, pq.Array(lowerAll(f.AnyOf)))
+		argCount++
+	}
+
+	if len(f.AllOf) > 0 {
+		names := lowerAll(f.AllOf)
+		query += fmt.Sprintf(" AND (SELECT COUNT(DISTINCT LOWER(tg.name)) FROM task_tags tt JOIN tags tg ON tg.id = tt.tag_id "+
+			"WHERE tt.task_id = tasks.id AND LOWER(tg.name) = ANY($%d)) = $%d", argCount, argCount+1)
+		args = append(args, pq.Array(names), distinctCount(names))
+		argCount += 2
+	}
+
+	if len(f.NoneOf) > 0 {
+		query += fmt.Sprintf(" AND NOT EXISTS ("+hasTag+")", argCount)
+		args = append(args, pq.Array(lowerAll(f.NoneOf)))
+		argCount++
+	}
+
+	return query, args, argCount
+}
+
+func lowerAll(names []string) []string {
+	lower := make([]string, len(names))
+	for i, name := range names {
+		lower[i] = strings.ToLower(name)
+	}
+	return lower
+}
+
+func distinctCount(names []string) int {
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		seen[name] = struct{}{}
+	}
+	return len(seen)
+}
@@ -0,0 +1,285 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// SetTotpSecret stores a pending secret. It is not used for login until
+// EnableTotp is called, and it can't replace the secret of enabled TOTP.
+func (s *Storage) SetTotpSecret(ctx context.Context, userID int64, secret string) error {
+	const op = "storage.postgres.SetTotpSecret"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE users SET totp_secret = $1, updated_at = NOW() WHERE id = $2 AND totp_enabled = FALSE")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, secret, userID)
+	if err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: get rows affected: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+	}
+
+	return nil
+}
+
+// GetTotpSecret returns the TOTP secret of the user, empty if none was enrolled.
+func (s *Storage) GetTotpSecret(ctx context.Context, userID int64) (string, error) {
+	const op = "storage.postgres.GetTotpSecret"
+
+	stmt, err := s.db.PrepareContext(ctx, "SELECT totp_secret FROM users WHERE id = $1")
+	if err != nil {
+		return "", fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	var secret sql.NullString
+	err = stmt.QueryRowContext(ctx, userID).Scan(&secret)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+		return "", fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return secret.String, nil
+}
+
+// EnableTotp turns the pending secret on and replaces recovery codes of the user.
+func (s *Storage) EnableTotp(ctx context.Context, userID int64, recoveryCodeHashes []string) error {
+	const op = "storage.postgres.EnableTotp"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		"UPDATE users SET totp_enabled = TRUE, updated_at = NOW() WHERE id = $1 AND totp_secret IS NOT NULL", userID)
+	if err != nil {
+		return fmt.Errorf("%s: update user: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: get rows affected: %w", op, err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM totp_recovery_codes WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("%s: delete recovery codes: %w", op, err)
+	}
+
+	for _, hash := range recoveryCodeHashes {
+		_, err := tx.ExecContext(ctx,
+			"INSERT INTO totp_recovery_codes (user_id, code_hash) VALUES ($1, $2)", userID, hash)
+		if err != nil {
+			return fmt.Errorf("%s: insert recovery code: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+// DisableTotp removes the secret and recovery codes of the user.
+func (s *Storage) DisableTotp(ctx context.Context, userID int64) error {
+	const op = "storage.postgres.DisableTotp"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		"UPDATE users SET totp_secret = NULL, totp_enabled = FALSE, totp_last_step = NULL, updated_at = NOW() "+
+			"WHERE id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("%s: update user: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: get rows affected: %w", op, err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM totp_recovery_codes WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("%s: delete recovery codes: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+// UseTotpStep records that a code of the given time step was accepted.
+// Steps that are not newer than the last used one yield ErrTotpStepUsed,
+// so an intercepted code can't be replayed.
+func (s *Storage) UseTotpStep(ctx context.Context, userID int64, step int64) error {
+	const op = "storage.postgres.UseTotpStep"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE users SET totp_last_step = $1 "+
+			"WHERE id = $2 AND (totp_last_step IS NULL OR totp_last_step < $1)")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, step, userID)
+	if err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: get rows affected: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrTotpStepUsed)
+	}
+
+	return nil
+}
+
+// ConsumeRecoveryCode marks an unused recovery code of the user as used.
+func (s *Storage) ConsumeRecoveryCode(ctx context.Context, userID int64, codeHash string) error {
+	const op = "storage.postgres.ConsumeRecoveryCode"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE totp_recovery_codes SET used_at = NOW() "+
+			"WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, userID, codeHash)
+	if err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: get rows affected: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrRecoveryCodeNotFound)
+	}
+
+	return nil
+}
+
+func (s *Storage) SaveMfaChallenge(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error {
+	const op = "storage.postgres.SaveMfaChallenge"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"INSERT INTO mfa_challenges (user_id, token_hash, expires_at) VALUES ($1, $2, $3)")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err = stmt.ExecContext(ctx, userID, tokenHash, expiresAt); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetMfaChallenge returns the user of an unused, unexpired challenge that
+// has had fewer than maxAttempts failed attempts.
+func (s *Storage) GetMfaChallenge(ctx context.Context, tokenHash string, maxAttempts int) (int64, error) {
+	const op = "storage.postgres.GetMfaChallenge"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"SELECT user_id FROM mfa_challenges "+
+			"WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW() AND attempts < $2")
+	if err != nil {
+		return 0, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	var userID int64
+	err = stmt.QueryRowContext(ctx, tokenHash, maxAttempts).Scan(&userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, fmt.Errorf("%s: %w", op, ErrMfaChallengeNotFound)
+		}
+		return 0, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return userID, nil
+}
+
+func (s *Storage) RecordMfaFailure(ctx context.Context, tokenHash string) error {
+	const op = "storage.postgres.RecordMfaFailure"
+
+	stmt, err := s.db.PrepareContext(ctx, "UPDATE mfa_challenges SET attempts = attempts + 1 WHERE token_hash = $1")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err = stmt.ExecContext(ctx, tokenHash); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
+
+// ConsumeMfaChallenge marks the challenge as used. A challenge that was
+// already used yields ErrMfaChallengeNotFound.
+func (s *Storage) ConsumeMfaChallenge(ctx context.Context, tokenHash string) error {
+	const op = "storage.postgres.ConsumeMfaChallenge"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE mfa_challenges SET used_at = NOW() WHERE token_hash = $1 AND used_at IS NULL")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, tokenHash)
+	if err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: get rows affected: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrMfaChallengeNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"mod1/internal/models"
+
+	"github.com/lib/pq"
+)
+
+// uniqueViolation is the PostgreSQL error code for unique constraint violations.
+const uniqueViolation = "23505"
+
+const userColumns = "id, username, email, password_hash, token_generation, role, disabled, email_verified, totp_enabled, created_at"
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanUser(row rowScanner) (models.User, error) {
+	var user models.User
+	err := row.Scan(&user.ID, &user.Username, &user.Email, &user.PassHash, &user.TokenGeneration,
+		&user.Role, &user.Disabled, &user.EmailVerified, &user.TotpEnabled, &user.CreatedAt)
+	return user, err
+}
+
+func (s *Storage) ListUsers(ctx context.Context, pageSize, pageToken int32) ([]models.User, error) {
+	const op = "storage.postgres.ListUsers"
+
+	stmt, err := s.db.PrepareContext(ctx, "SELECT "+userColumns+" FROM users ORDER BY id LIMIT $1 OFFSET $2")
+	if err != nil {
+		return nil, fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, pageSize, pageSize*pageToken)
+	if err != nil {
+		return nil, fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+	defer rows.Close()
+
+	var users []models.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: scan row: %w", op, err)
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: rows error: %w", op, err)
+	}
+
+	return users, nil
+}
+
+// SetUserDisabled changes disabled flag of the user. Disabling also bumps
+// token generation so that already issued access tokens stop working.
+func (s *Storage) SetUserDisabled(ctx context.Context, userID int64, disabled bool) error {
+	const op = "storage.postgres.SetUserDisabled"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE users SET disabled = $1, "+
+			"token_generation = CASE WHEN $1 THEN token_generation + 1 ELSE token_generation END, "+
+			"updated_at = NOW() WHERE id = $2")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, disabled, userID)
+	if err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: get rows affected: %w", op, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+	}
+
+	return nil
+}
+
+// UpdateProfile changes username and email of the user. Changing the email
+// resets email_verified, so the new address has to be confirmed again, and
+// voids verification tokens sent to the old one.
+func (s *Storage) UpdateProfile(ctx context.Context, userID int64, username, email string) (models.User, error) {
+	const op = "storage.postgres.UpdateProfile"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.User{}, fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var oldEmail string
+	err = tx.QueryRowContext(ctx, "SELECT email FROM users WHERE id = $1 FOR UPDATE", userID).Scan(&oldEmail)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.User{}, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+		return models.User{}, fmt.Errorf("%s: get user: %w", op, err)
+	}
+
+	user, err := scanUser(tx.QueryRowContext(ctx,
+		"UPDATE users SET username = $1, email = $2, email_verified = email_verified AND email = $2, "+
+			"updated_at = NOW() WHERE id = $3 RETURNING "+userColumns,
+		username, email, userID))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return models.User{}, fmt.Errorf("%s: %w", op, ErrUserExists)
+		}
+		return models.User{}, fmt.Errorf("%s: update user: %w", op, err)
+	}
+
+	if email != oldEmail {
+		_, err = tx.ExecContext(ctx,
+			"UPDATE email_verification_tokens SET used_at = NOW() WHERE user_id = $1 AND used_at IS NULL", userID)
+		if err != nil {
+			return models.User{}, fmt.Errorf("%s: invalidate verification tokens: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.User{}, fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return user, nil
+}
+
+// RehashPassword replaces the password hash of the user with newHash, but
+// only if it is still oldHash, so a concurrent password change wins.
+func (s *Storage) RehashPassword(ctx context.Context, userID int64, oldHash, newHash []byte) error {
+	const op = "storage.postgres.RehashPassword"
+
+	stmt, err := s.db.PrepareContext(ctx,
+		"UPDATE users SET password_hash = $1 WHERE id = $2 AND password_hash = $3")
+	if err != nil {
+		return fmt.Errorf("%s: prepare statement: %w", op, err)
+	}
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, newHash, userID, oldHash); err != nil {
+		return fmt.Errorf("%s: execute statement: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteUser removes the user together with all their tasks.
+// Auth data (tokens, keys, etc.) is removed by ON DELETE CASCADE.
+func (s *Storage) DeleteUser(ctx context.Context, userID int64) error {
+	const op = "storage.postgres.DeleteUser"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM tasks WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("%s: delete tasks: %w", op, err)
+	}
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM users WHERE id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("%s: delete user: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: get rows affected: %w", op, err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+// AnonymizeUser strips personal data from the user and disables the account.
+// The row stays in place so that tasks of the user are kept, but they can no
+// longer be linked to a person.
+func (s *Storage) AnonymizeUser(ctx context.Context, userID int64) error {
+	const op = "storage.postgres.AnonymizeUser"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: begin transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var email string
+	err = tx.QueryRowContext(ctx, "SELECT email FROM users WHERE id = $1 FOR UPDATE", userID).Scan(&email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+		return fmt.Errorf("%s: get user: %w", op, err)
+	}
+
+	res, err := tx.ExecContext(ctx,
+		"UPDATE users SET username = 'deleted-' || id, email = 'deleted-' || id || '@deleted.invalid', "+
+			"password_hash = '', disabled = TRUE, email_verified = FALSE, "+
+			"totp_secret = NULL, totp_enabled = FALSE, totp_last_step = NULL, "+
+			"token_generation = token_generation + 1, updated_at = NOW() WHERE id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("%s: update user: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: get rows affected: %w", op, err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+	}
+
+	for _, query := range []string{
+		"DELETE FROM refresh_tokens WHERE user_id = $1",
+		"DELETE FROM sessions WHERE user_id = $1",
+		"DELETE FROM api_keys WHERE user_id = $1",
+		"DELETE FROM totp_recovery_codes WHERE user_id = $1",
+		"DELETE FROM mfa_challenges WHERE user_id = $1",
+		"DELETE FROM password_reset_tokens WHERE user_id = $1",
+		"DELETE FROM email_verification_tokens WHERE user_id = $1",
+		"DELETE FROM user_identities WHERE user_id = $1",
+	} {
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return fmt.Errorf("%s: delete auth data: %w", op, err)
+		}
+	}
+
+	// Ключ троттлинга содержит email (см. accountThrottleKey в services/auth).
+	_, err = tx.ExecContext(ctx,
+		"DELETE FROM login_throttle WHERE key = 'account:' || LOWER(TRIM($1))", email)
+	if err != nil {
+		return fmt.Errorf("%s: delete login throttle: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation
+}
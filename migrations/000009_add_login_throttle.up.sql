@@ -0,0 +1,7 @@
+-- Failed login attempts per throttling key ("account:<email>" or "ip:<address>").
+CREATE TABLE IF NOT EXISTS login_throttle (
+    key VARCHAR(320) PRIMARY KEY,
+    failures INT NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
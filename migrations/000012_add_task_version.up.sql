@@ -0,0 +1,3 @@
+-- Version is bumped on every change of a task and lets clients detect
+-- concurrent edits (optimistic locking).
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,15 @@
+-- Responses of mutating calls made with an Idempotency-Key, replayed when
+-- the client retries the call. response is NULL while the call is running.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    idem_key VARCHAR(255) NOT NULL,
+    method VARCHAR(255) NOT NULL,
+    request_hash VARCHAR(64) NOT NULL,
+    response BYTEA,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (user_id, idem_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
@@ -0,0 +1,4 @@
+-- Priority: 1 low, 2 medium, 3 high, 4 urgent (TaskPriority in the API).
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS priority SMALLINT NOT NULL DEFAULT 2;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_user_id_priority ON tasks(user_id, priority);
@@ -0,0 +1,4 @@
+-- Subtasks. Depth and absence of cycles are enforced by the application.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS parent_id INT REFERENCES tasks(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_parent_id ON tasks(parent_id);
@@ -0,0 +1,10 @@
+-- task_id is blocked by blocked_by_id. Cycles are rejected by the application.
+CREATE TABLE IF NOT EXISTS task_dependencies (
+    task_id INT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    blocked_by_id INT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (task_id, blocked_by_id),
+    CHECK (task_id <> blocked_by_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_blocked_by_id ON task_dependencies(blocked_by_id);
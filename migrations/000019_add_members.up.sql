@@ -0,0 +1,22 @@
+-- Users tasks and projects are shared with. role: 1 viewer, 2 editor, 3 owner.
+-- The creator (user_id of the task or project) is the owner without a row here.
+CREATE TABLE IF NOT EXISTS task_members (
+    task_id INT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role SMALLINT NOT NULL CHECK (role BETWEEN 1 AND 3),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (task_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_members_user_id ON task_members(user_id);
+
+-- Members of a project have their role on every task of the project.
+CREATE TABLE IF NOT EXISTS project_members (
+    project_id INT NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role SMALLINT NOT NULL CHECK (role BETWEEN 1 AND 3),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (project_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_members_user_id ON project_members(user_id);
@@ -0,0 +1,14 @@
+-- Every access token issued for a session, not only the latest one, so that
+-- ending the session also rejects tokens issued before its last refresh.
+CREATE TABLE IF NOT EXISTS session_tokens (
+    jti VARCHAR(64) PRIMARY KEY,
+    session_id INT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_session_tokens_session_id ON session_tokens(session_id);
+
+-- Expiry of tokens issued so far is not known; a day outlives the default access token TTL.
+INSERT INTO session_tokens (jti, session_id, expires_at)
+SELECT access_jti, id, NOW() + INTERVAL '1 day' FROM sessions WHERE access_jti IS NOT NULL
+ON CONFLICT DO NOTHING;